@@ -7,16 +7,16 @@ import (
 )
 
 // container - это интерфейс, описывающий контейнер, поддерживающий базовые операции работы с элементами.
-// Контейнер должен предоставлять возможность добавления элементов в конец, удаления из начала,
-// доступа к первому элементу, а также итерацию по элементам с помощью итераторов.
+// Контейнер должен предоставлять возможность добавления и удаления элементов в конце,
+// доступа к первому элементу, а также итерацию по элементам с помощью итераторов с произвольным доступом.
 type container[T any] interface {
 	interfaces.Container[T]
 	// Front возвращает первый элемент в контейнере.
 	Front() T
 	// PushBack добавляет элемент в конец контейнера.
 	PushBack(T)
-	// PopFront удаляет первый элемент из контейнера.
-	PopFront()
+	// PopBack удаляет последний элемент из контейнера.
+	PopBack()
 	// Begin возвращает итератор на начало контейнера.
 	Begin() interfaces.RandomAccessIterator[T]
 	// End возвращает итератор на конец контейнера.
@@ -29,18 +29,18 @@ type container[T any] interface {
 // Возвращает: созданный контейнер C, содержащий элементы.
 type containerConstructor[T any, C container[T]] func(...T) C
 
-// PriorityQueue представляет собой приоритетную очередь, которая поддерживает сортировку элементов
-// согласно переданному компаратору. Очередь работает на основе контейнера C, который управляет хранением элементов.
+// PriorityQueue представляет собой приоритетную очередь на основе двоичной кучи, хранящейся в контейнере C.
+// Наивысший приоритет имеет наименьший согласно компаратору элемент.
 // Тип T - это тип элементов, хранящихся в очереди.
 // Тип C - это тип контейнера, реализующий интерфейс container, используемый для управления элементами.
 type PriorityQueue[T any, C container[T]] struct {
 	c    C                  // Контейнер, реализующий функциональность очереди.
-	comp comparator.Less[T] // Компаратор, используемый для сортировки элементов по приоритету.
+	comp comparator.Less[T] // Компаратор, определяющий приоритет элементов.
 }
 
 // NewPriorityQueue создает новую приоритетную очередь
 // с использованием указанного конструктора контейнера и компаратора.
-// Элементы будут отсортированы согласно компаратору при создании очереди.
+// Переданные элементы преобразуются в кучу за линейное время.
 // Параметры:
 // - cc: функция-конструктор, которая создает контейнер.
 // - comp: функция-компаратор, определяющая приоритет элементов в очереди.
@@ -55,19 +55,22 @@ func NewPriorityQueue[T any, C container[T]](
 		c:    cc(items...),
 		comp: comp,
 	}
-	// Сортировка элементов в контейнере по заданному компаратору.
-	algorithms.SortC(q.c.Begin(), q.c.End(), comp)
+	algorithms.MakeHeap(q.c.Begin(), q.c.End(), q.heapLess)
 	return q
 }
 
-// Push добавляет новый элемент в приоритетную очередь. После добавления, элементы переупорядочиваются
-// согласно компаратору, чтобы поддерживать правильный порядок приоритетов.
+// heapLess упорядочивает кучу так, что в ее корне оказывается наименьший согласно компаратору элемент.
+// Функции кучи пакета algorithms строят кучу с наибольшим элементом в корне, поэтому компаратор обращается.
+func (q *PriorityQueue[T, C]) heapLess(a, b T) bool {
+	return q.comp(b, a)
+}
+
+// Push добавляет новый элемент в приоритетную очередь за O(log n).
 // Параметры:
 // - value: элемент, который будет добавлен в очередь.
 func (q *PriorityQueue[T, C]) Push(value T) {
 	q.c.PushBack(value)
-	// Переупорядочивание элементов после добавления нового.
-	algorithms.SortC(q.c.Begin(), q.c.End(), q.comp)
+	algorithms.PushHeap(q.c.Begin(), q.c.End(), q.heapLess)
 }
 
 // Top возвращает элемент с наивысшим приоритетом (первый элемент в очереди) без его удаления.
@@ -76,9 +79,14 @@ func (q *PriorityQueue[T, C]) Top() T {
 	return q.c.Front()
 }
 
-// Pop удаляет элемент с наивысшим приоритетом (первый элемент) из очереди.
+// Pop удаляет элемент с наивысшим приоритетом из очереди за O(log n).
+// Элемент перемещается в конец контейнера и удаляется оттуда.
 func (q *PriorityQueue[T, C]) Pop() {
-	q.c.PopFront()
+	if q.c.IsEmpty() {
+		return
+	}
+	algorithms.PopHeap(q.c.Begin(), q.c.End(), q.heapLess)
+	q.c.PopBack()
 }
 
 // Size возвращает количество элементов в приоритетной очереди.
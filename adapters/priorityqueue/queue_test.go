@@ -0,0 +1,56 @@
+package priorityqueue
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/sequence/deque"
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+func TestPriorityQueueOrder(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	initial := rnd.Perm(50)
+	q := NewPriorityQueue(deque.NewDeque[int], comparator.DefaultLess[int](), initial...)
+	model := slices.Clone(initial)
+
+	for step := 0; step < 2000; step++ {
+		if rnd.Intn(3) > 0 || len(model) == 0 {
+			v := rnd.Intn(100)
+			q.Push(v)
+			model = append(model, v)
+			continue
+		}
+		slices.Sort(model)
+		assert.Equal(t, model[0], q.Top())
+		q.Pop()
+		model = model[1:]
+		assert.Equal(t, uint(len(model)), q.Size())
+	}
+
+	slices.Sort(model)
+	for _, v := range model {
+		assert.Equal(t, v, q.Top())
+		q.Pop()
+	}
+	assert.True(t, q.IsEmpty())
+	q.Pop()
+	assert.True(t, q.IsEmpty())
+}
+
+func TestPriorityQueueCustomComparator(t *testing.T) {
+	greater := func(a, b int) bool { return a > b }
+	q := NewPriorityQueue(vector.NewVector[int], greater, 3, 1, 4, 1, 5)
+	q.Push(9)
+	q.Push(2)
+
+	var got []int
+	for ; !q.IsEmpty(); q.Pop() {
+		got = append(got, q.Top())
+	}
+	assert.Equal(t, []int{9, 5, 4, 3, 2, 1, 1}, got)
+}
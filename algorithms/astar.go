@@ -0,0 +1,59 @@
+package algorithms
+
+// AStar находит кратчайший путь от вершины source до вершины target алгоритмом A*.
+// Веса ребер должны быть неотрицательными, иначе возникает паника.
+// Эвристика должна быть допустимой, то есть не превосходить истинное расстояние до target,
+// иначе найденный путь может оказаться не кратчайшим.
+// При согласованной эвристике каждая вершина раскрывается не более одного раза
+// и алгоритм выполняет O((V + E) * log V) операций, как Dijkstra;
+// допустимая, но несогласованная эвристика может приводить к повторному раскрытию вершин.
+// Очередь вершин - внутренняя двоичная куча пакета, как у Dijkstra.
+//
+// Параметры:
+// - neighbors: функция перечисления исходящих ребер вершины.
+// - source: исходная вершина.
+// - target: целевая вершина.
+// - heuristic: оценка расстояния от вершины до target.
+//
+// Возвращает:
+// - вершины пути от source до target включительно.
+// - длину пути.
+// - true, если target достижима.
+func AStar[V comparable, W Weight](
+	neighbors Neighbors[V, W],
+	source, target V,
+	heuristic func(v V) W,
+) ([]V, W, bool) {
+	dist := map[V]W{source: 0}
+	prev := make(map[V]V)
+	q := newFrontier[V, W]()
+	q.Push(frontierItem[V, W]{v: source, priority: heuristic(source)})
+
+	for !q.IsEmpty() {
+		item := q.Top()
+		q.Pop()
+		// Устаревший элемент: до вершины уже найден более короткий путь.
+		if item.dist > dist[item.v] {
+			continue
+		}
+		if item.v == target {
+			return buildPath(prev, source, target), item.dist, true
+		}
+
+		neighbors(item.v, func(to V, weight W) {
+			if weight < 0 {
+				panic("negative edge weight")
+			}
+			d := item.dist + weight
+			if known, found := dist[to]; found && known <= d {
+				return
+			}
+			dist[to] = d
+			prev[to] = item.v
+			q.Push(frontierItem[V, W]{v: to, dist: d, priority: d + heuristic(to)})
+		})
+	}
+
+	var zero W
+	return nil, zero, false
+}
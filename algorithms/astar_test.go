@@ -0,0 +1,52 @@
+package algorithms_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/algorithms"
+)
+
+type cell struct {
+	x, y int
+}
+
+func TestAStar(t *testing.T) {
+	// Сетка 5x5 со стеной в столбце x = 2, проход через y = 4.
+	grid := func(v cell, f func(to cell, weight int)) {
+		for _, d := range []cell{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			to := cell{v.x + d.x, v.y + d.y}
+			if to.x < 0 || to.y < 0 || to.x > 4 || to.y > 4 || (to.x == 2 && to.y < 4) {
+				continue
+			}
+			f(to, 1)
+		}
+	}
+	target := cell{4, 0}
+	manhattan := func(v cell) int {
+		return max(target.x-v.x, v.x-target.x) + max(target.y-v.y, v.y-target.y)
+	}
+
+	path, dist, ok := algorithms.AStar(grid, cell{0, 0}, target, manhattan)
+
+	assert.True(t, ok)
+	assert.Equal(t, 12, dist)
+	assert.Len(t, path, 13)
+	assert.Equal(t, cell{0, 0}, path[0])
+	assert.Equal(t, target, path[len(path)-1])
+	assert.Contains(t, path, cell{2, 4})
+}
+
+func TestAStarUnreachable(t *testing.T) {
+	neighbors := func(v int, f func(to int, weight int)) {
+		if v < 3 {
+			f(v+1, 1)
+		}
+	}
+
+	path, _, ok := algorithms.AStar(neighbors, 0, 10, func(int) int { return 0 })
+
+	assert.False(t, ok)
+	assert.Nil(t, path)
+}
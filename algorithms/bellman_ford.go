@@ -0,0 +1,72 @@
+package algorithms
+
+// BellmanFord находит кратчайшие пути от вершины source до всех достижимых из нее вершин алгоритмом Беллмана-Форда.
+// В отличие от Dijkstra допускает ребра отрицательного веса.
+// Если из source достижим цикл отрицательного веса, возвращает ошибку *NegativeCycleError с вершинами цикла.
+//
+// Параметры:
+// - neighbors: функция перечисления исходящих ребер вершины.
+// - source: исходная вершина.
+//
+// Возвращает:
+// - кратчайшие расстояния и пути от source.
+// - ошибку *NegativeCycleError, если кратчайшие расстояния не определены.
+func BellmanFord[V comparable, W Weight](neighbors Neighbors[V, W], source V) (*ShortestPaths[V, W], error) {
+	// Собираем ребра достижимой из source части графа.
+	var edges []Edge[V, W]
+	vertices := []V{source}
+	seen := map[V]struct{}{source: {}}
+	for i := 0; i < len(vertices); i++ {
+		from := vertices[i]
+		neighbors(from, func(to V, weight W) {
+			edges = append(edges, Edge[V, W]{From: from, To: to, Weight: weight})
+			if _, found := seen[to]; !found {
+				seen[to] = struct{}{}
+				vertices = append(vertices, to)
+			}
+		})
+	}
+
+	paths := newShortestPaths[V, W](source)
+	relax := func() (V, bool) {
+		var last V
+		relaxed := false
+		for _, e := range edges {
+			d, found := paths.dist[e.From]
+			if !found {
+				continue
+			}
+			if known, found := paths.dist[e.To]; found && known <= d+e.Weight {
+				continue
+			}
+			paths.dist[e.To] = d + e.Weight
+			paths.prev[e.To] = e.From
+			last, relaxed = e.To, true
+		}
+		return last, relaxed
+	}
+
+	for i := 1; i < len(vertices); i++ {
+		if _, relaxed := relax(); !relaxed {
+			return paths, nil
+		}
+	}
+	v, relaxed := relax()
+	if !relaxed {
+		return paths, nil
+	}
+
+	// Вершина, расстояние до которой уменьшилось на |V|-м проходе, достижима из отрицательного цикла.
+	// Возврат по ссылкам |V| раз гарантированно приводит в вершину самого цикла.
+	for range vertices {
+		v = paths.prev[v]
+	}
+	cycle := []V{v}
+	for u := paths.prev[v]; u != v; u = paths.prev[u] {
+		cycle = append(cycle, u)
+	}
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return nil, &NegativeCycleError[V]{Cycle: cycle}
+}
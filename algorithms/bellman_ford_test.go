@@ -0,0 +1,42 @@
+package algorithms_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/algorithms"
+	"github.com/Delisa-sama/collections/graph"
+)
+
+func TestBellmanFord(t *testing.T) {
+	g := graph.NewDirectedGraph[string, int]()
+	g.AddEdge("s", "a", 4)
+	g.AddEdge("s", "b", 5)
+	g.AddEdge("b", "a", -3)
+	g.AddEdge("a", "c", 2)
+
+	paths, err := algorithms.BellmanFord(g.ForEachNeighbor, "s")
+
+	assert.NoError(t, err)
+	dist, _ := paths.Distance("c")
+	assert.Equal(t, 4, dist)
+	assert.Equal(t, []string{"s", "b", "a", "c"}, paths.PathTo("c"))
+}
+
+func TestBellmanFordNegativeCycle(t *testing.T) {
+	g := graph.NewDirectedGraph[int, int]()
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, -1)
+	g.AddEdge(3, 1, -1)
+	g.AddEdge(3, 4, 1)
+
+	paths, err := algorithms.BellmanFord(g.ForEachNeighbor, 0)
+
+	assert.Nil(t, paths)
+	var cycleErr *algorithms.NegativeCycleError[int]
+	assert.True(t, errors.As(err, &cycleErr))
+	assert.ElementsMatch(t, []int{1, 2, 3}, cycleErr.Cycle)
+}
@@ -0,0 +1,43 @@
+package algorithms
+
+// Dijkstra находит кратчайшие пути от вершины source до всех достижимых из нее вершин алгоритмом Дейкстры.
+// Веса ребер должны быть неотрицательными, иначе возникает паника.
+// Использует двоичную кучу, поэтому выполняет O((V + E) * log V) операций,
+// где V и E - количество достижимых из source вершин и ребер.
+// Куча реализована внутри пакета, а не через adapters/priorityqueue, который сам импортирует algorithms.
+//
+// Параметры:
+// - neighbors: функция перечисления исходящих ребер вершины.
+// - source: исходная вершина.
+//
+// Возвращает:
+// - кратчайшие расстояния и пути от source.
+func Dijkstra[V comparable, W Weight](neighbors Neighbors[V, W], source V) *ShortestPaths[V, W] {
+	paths := newShortestPaths[V, W](source)
+	done := make(map[V]struct{})
+	q := newFrontier[V, W]()
+	q.Push(frontierItem[V, W]{v: source})
+
+	for !q.IsEmpty() {
+		item := q.Top()
+		q.Pop()
+		if _, settled := done[item.v]; settled {
+			continue
+		}
+		done[item.v] = struct{}{}
+
+		neighbors(item.v, func(to V, weight W) {
+			if weight < 0 {
+				panic("negative edge weight")
+			}
+			dist := item.dist + weight
+			if d, found := paths.dist[to]; found && d <= dist {
+				return
+			}
+			paths.dist[to] = dist
+			paths.prev[to] = item.v
+			q.Push(frontierItem[V, W]{v: to, dist: dist, priority: dist})
+		})
+	}
+	return paths
+}
@@ -0,0 +1,40 @@
+package algorithms_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/algorithms"
+	"github.com/Delisa-sama/collections/graph"
+)
+
+func TestDijkstra(t *testing.T) {
+	g := graph.NewDirectedGraph[string, int]()
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("c", "b", 2)
+	g.AddEdge("b", "d", 1)
+	g.AddEdge("c", "d", 5)
+	g.AddVertex("e")
+
+	paths := algorithms.Dijkstra(g.ForEachNeighbor, "a")
+
+	dist, ok := paths.Distance("d")
+	assert.True(t, ok)
+	assert.Equal(t, 4, dist)
+	assert.Equal(t, []string{"a", "c", "b", "d"}, paths.PathTo("d"))
+	assert.Equal(t, []string{"a"}, paths.PathTo("a"))
+
+	assert.False(t, paths.Reachable("e"))
+	assert.Nil(t, paths.PathTo("e"))
+}
+
+func TestDijkstraNegativeWeight(t *testing.T) {
+	g := graph.NewDirectedGraph[int, float64]()
+	g.AddEdge(1, 2, -1)
+
+	assert.Panics(t, func() {
+		algorithms.Dijkstra(g.ForEachNeighbor, 1)
+	})
+}
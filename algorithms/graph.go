@@ -0,0 +1,154 @@
+package algorithms
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+// Neighbors - это функция, вызывающая f для каждого ребра, исходящего из вершины v.
+// Задает граф для алгоритмов на графах, которые поэтому не зависят от его представления:
+// подходит метод graph.Graph.ForEachNeighbor или любая функция с той же сигнатурой.
+type Neighbors[V any, W interfaces.Numeric] func(v V, f func(to V, weight W))
+
+// Weight объединяет под собой числовые типы, для которых определен порядок, то есть все, кроме комплексных.
+type Weight interface {
+	interfaces.Numeric
+	cmp.Ordered
+}
+
+// Edge представляет взвешенное ребро графа.
+type Edge[V any, W any] struct {
+	From   V
+	To     V
+	Weight W
+}
+
+// CycleError возвращается, если алгоритм требует ациклический граф, а граф содержит цикл.
+type CycleError[V any] struct {
+	// Cycle - вершины цикла в порядке обхода, первая вершина не повторяется в конце.
+	Cycle []V
+}
+
+// Error возвращает описание ошибки.
+func (e *CycleError[V]) Error() string {
+	return fmt.Sprintf("graph contains a cycle: %v", e.Cycle)
+}
+
+// NegativeCycleError возвращается, если из исходной вершины достижим цикл отрицательного веса
+// и кратчайшие расстояния не определены.
+type NegativeCycleError[V any] struct {
+	// Cycle - вершины цикла в порядке обхода, первая вершина не повторяется в конце.
+	Cycle []V
+}
+
+// Error возвращает описание ошибки.
+func (e *NegativeCycleError[V]) Error() string {
+	return fmt.Sprintf("graph contains a negative cycle: %v", e.Cycle)
+}
+
+// ShortestPaths хранит кратчайшие расстояния от исходной вершины и дерево кратчайших путей.
+type ShortestPaths[V comparable, W Weight] struct {
+	source V
+	dist   map[V]W
+	prev   map[V]V
+}
+
+// newShortestPaths создает результат поиска, в котором достижима только исходная вершина.
+func newShortestPaths[V comparable, W Weight](source V) *ShortestPaths[V, W] {
+	var zero W
+	return &ShortestPaths[V, W]{
+		source: source,
+		dist:   map[V]W{source: zero},
+		prev:   make(map[V]V),
+	}
+}
+
+// Source возвращает исходную вершину.
+func (p *ShortestPaths[V, W]) Source() V {
+	return p.source
+}
+
+// Distance возвращает кратчайшее расстояние до вершины v и признак ее достижимости.
+func (p *ShortestPaths[V, W]) Distance(v V) (W, bool) {
+	d, found := p.dist[v]
+	return d, found
+}
+
+// Reachable проверяет, достижима ли вершина v из исходной вершины.
+func (p *ShortestPaths[V, W]) Reachable(v V) bool {
+	_, found := p.dist[v]
+	return found
+}
+
+// PathTo возвращает вершины кратчайшего пути от исходной вершины до v включительно.
+// Если v недостижима, возвращает nil.
+func (p *ShortestPaths[V, W]) PathTo(v V) []V {
+	if !p.Reachable(v) {
+		return nil
+	}
+	return buildPath(p.prev, p.source, v)
+}
+
+// buildPath восстанавливает путь от source до v по ссылкам на предыдущие вершины.
+func buildPath[V comparable](prev map[V]V, source, v V) []V {
+	path := []V{v}
+	for v != source {
+		v = prev[v]
+		path = append(path, v)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// frontierItem - вершина в приоритетной очереди.
+type frontierItem[V any, W Weight] struct {
+	v        V
+	from     V // Вершина, из которой v была добавлена в очередь.
+	dist     W // Расстояние от исходной вершины, с которым вершина была добавлена в очередь.
+	priority W
+}
+
+// frontier - приоритетная очередь вершин на основе двоичной кучи.
+// Top возвращает вершину с наименьшим приоритетом, добавление и извлечение выполняются за O(log n).
+// Повторяет priorityqueue.PriorityQueue на тех же функциях PushHeap и PopHeap:
+// пакет adapters/priorityqueue импортирует algorithms, поэтому использовать его здесь нельзя.
+type frontier[V any, W Weight] struct {
+	heap *vector.Vector[frontierItem[V, W]]
+}
+
+// newFrontier создает пустую приоритетную очередь вершин.
+func newFrontier[V any, W Weight]() *frontier[V, W] {
+	return &frontier[V, W]{heap: vector.NewVector[frontierItem[V, W]]()}
+}
+
+// lowerPriority упорядочивает кучу так, что в ее корне оказывается вершина с наименьшим приоритетом.
+func lowerPriority[V any, W Weight](a, b frontierItem[V, W]) bool {
+	return a.priority > b.priority
+}
+
+// IsEmpty проверяет что очередь пустая.
+func (f *frontier[V, W]) IsEmpty() bool {
+	return f.heap.IsEmpty()
+}
+
+// Push добавляет вершину в очередь.
+func (f *frontier[V, W]) Push(item frontierItem[V, W]) {
+	f.heap.PushBack(item)
+	PushHeap(f.heap.Begin(), f.heap.End(), lowerPriority[V, W])
+}
+
+// Top возвращает вершину с наименьшим приоритетом.
+func (f *frontier[V, W]) Top() frontierItem[V, W] {
+	return f.heap.Front()
+}
+
+// Pop удаляет вершину с наименьшим приоритетом.
+func (f *frontier[V, W]) Pop() {
+	PopHeap(f.heap.Begin(), f.heap.End(), lowerPriority[V, W])
+	f.heap.PopBack()
+}
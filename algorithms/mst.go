@@ -0,0 +1,95 @@
+package algorithms
+
+import (
+	"github.com/Delisa-sama/collections/associative/disjointset"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+// Prim строит минимальное остовное дерево компоненты связности, содержащей вершину start, алгоритмом Прима.
+// Граф должен быть неориентированным: каждое ребро перечисляется из обеих его вершин.
+// Использует двоичную кучу, поэтому выполняет O(E * log V) операций, где E и V - размеры компоненты.
+// Очередь вершин - внутренняя куча пакета, та же, что у Dijkstra, вместо adapters/priorityqueue.
+//
+// Параметры:
+// - neighbors: функция перечисления ребер вершины.
+// - start: вершина, с которой начинается построение дерева.
+//
+// Возвращает:
+// - ребра дерева в порядке их добавления.
+// - суммарный вес дерева.
+func Prim[V comparable, W Weight](neighbors Neighbors[V, W], start V) ([]Edge[V, W], W) {
+	var (
+		tree  []Edge[V, W]
+		total W
+	)
+	inTree := make(map[V]struct{})
+	// Приоритет элемента - вес ребра, которым вершина присоединяется к дереву.
+	q := newFrontier[V, W]()
+	q.Push(frontierItem[V, W]{v: start})
+
+	for !q.IsEmpty() {
+		item := q.Top()
+		q.Pop()
+		if _, found := inTree[item.v]; found {
+			continue
+		}
+		inTree[item.v] = struct{}{}
+		if item.v != start {
+			tree = append(tree, Edge[V, W]{From: item.from, To: item.v, Weight: item.priority})
+			total += item.priority
+		}
+
+		neighbors(item.v, func(to V, weight W) {
+			if _, found := inTree[to]; found {
+				return
+			}
+			q.Push(frontierItem[V, W]{v: to, from: item.v, priority: weight})
+		})
+	}
+	return tree, total
+}
+
+// Kruskal строит минимальный остовный лес графа алгоритмом Краскала.
+// Граф должен быть неориентированным. Связность компонент отслеживается системой непересекающихся множеств.
+//
+// Параметры:
+// - begin: итератор на первую вершину графа.
+// - end: итератор на конец последовательности вершин.
+// - neighbors: функция перечисления ребер вершины.
+//
+// Возвращает:
+// - ребра леса в порядке неубывания веса.
+// - суммарный вес леса.
+func Kruskal[V comparable, W Weight](
+	begin interfaces.ForwardIterator[V],
+	end interfaces.Iterator,
+	neighbors Neighbors[V, W],
+) ([]Edge[V, W], W) {
+	components := disjointset.NewDisjointSet[V]()
+	edges := vector.NewVector[Edge[V, W]]()
+	for it := copiable.Copy[interfaces.ForwardIterator[V]](begin); !it.Equals(end); it.Next() {
+		from := it.Value()
+		components.Add(from)
+		neighbors(from, func(to V, weight W) {
+			edges.PushBack(Edge[V, W]{From: from, To: to, Weight: weight})
+		})
+	}
+	SortC(edges.Begin(), edges.End(), func(a, b Edge[V, W]) bool {
+		return a.Weight < b.Weight
+	})
+
+	var (
+		forest []Edge[V, W]
+		total  W
+	)
+	for it := edges.Begin(); !it.Equals(edges.End()); it.Next() {
+		e := it.Value()
+		if components.Union(e.From, e.To) {
+			forest = append(forest, e)
+			total += e.Weight
+		}
+	}
+	return forest, total
+}
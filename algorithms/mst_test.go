@@ -0,0 +1,49 @@
+package algorithms_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/algorithms"
+	"github.com/Delisa-sama/collections/graph"
+)
+
+func newMSTGraph() *graph.Graph[string, int] {
+	g := graph.NewUndirectedGraph[string, int]()
+	g.AddEdge("a", "b", 7)
+	g.AddEdge("a", "d", 5)
+	g.AddEdge("b", "c", 8)
+	g.AddEdge("b", "d", 9)
+	g.AddEdge("b", "e", 7)
+	g.AddEdge("c", "e", 5)
+	g.AddEdge("d", "e", 15)
+	g.AddEdge("d", "f", 6)
+	g.AddEdge("e", "f", 8)
+	g.AddEdge("e", "g", 9)
+	g.AddEdge("f", "g", 11)
+	g.AddEdge("x", "y", 1)
+	return g
+}
+
+func TestPrim(t *testing.T) {
+	g := newMSTGraph()
+
+	tree, total := algorithms.Prim(g.ForEachNeighbor, "a")
+
+	assert.Equal(t, 39, total)
+	assert.Len(t, tree, 6)
+	assert.Equal(t, algorithms.Edge[string, int]{From: "a", To: "d", Weight: 5}, tree[0])
+}
+
+func TestKruskal(t *testing.T) {
+	g := newMSTGraph()
+
+	forest, total := algorithms.Kruskal(g.VerticesBegin(), g.VerticesEnd(), g.ForEachNeighbor)
+
+	assert.Equal(t, 40, total)
+	assert.Len(t, forest, 7)
+	for i := 1; i < len(forest); i++ {
+		assert.LessOrEqual(t, forest[i-1].Weight, forest[i].Weight)
+	}
+}
@@ -0,0 +1,73 @@
+package algorithms
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+)
+
+// StronglyConnectedComponents разбивает вершины ориентированного графа на компоненты сильной связности алгоритмом Тарьяна.
+// Компоненты возвращаются в обратном топологическом порядке графа компонент:
+// ни одно ребро не ведет из компоненты в компоненту, следующую после нее.
+//
+// Параметры:
+// - begin: итератор на первую вершину графа.
+// - end: итератор на конец последовательности вершин.
+// - neighbors: функция перечисления исходящих ребер вершины.
+//
+// Возвращает:
+// - компоненты сильной связности, включая компоненты достижимых вершин, отсутствующих в диапазоне.
+func StronglyConnectedComponents[V comparable, W interfaces.Numeric](
+	begin interfaces.ForwardIterator[V],
+	end interfaces.Iterator,
+	neighbors Neighbors[V, W],
+) [][]V {
+	var (
+		components [][]V
+		stack      []V
+		counter    int
+	)
+	index := make(map[V]int)   // Порядковый номер открытия вершины.
+	lowLink := make(map[V]int) // Наименьший номер вершины, достижимой из поддерева обхода и лежащей на стеке.
+	onStack := make(map[V]bool)
+
+	var visit func(v V)
+	visit = func(v V) {
+		index[v] = counter
+		lowLink[v] = counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		neighbors(v, func(to V, _ W) {
+			if _, visited := index[to]; !visited {
+				visit(to)
+				lowLink[v] = min(lowLink[v], lowLink[to])
+			} else if onStack[to] {
+				lowLink[v] = min(lowLink[v], index[to])
+			}
+		})
+
+		if lowLink[v] != index[v] {
+			return
+		}
+		// v - корень компоненты: компонента состоит из вершин стека начиная с v.
+		var component []V
+		for {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[u] = false
+			component = append(component, u)
+			if u == v {
+				break
+			}
+		}
+		components = append(components, component)
+	}
+
+	for it := copiable.Copy[interfaces.ForwardIterator[V]](begin); !it.Equals(end); it.Next() {
+		if _, visited := index[it.Value()]; !visited {
+			visit(it.Value())
+		}
+	}
+	return components
+}
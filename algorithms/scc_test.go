@@ -0,0 +1,28 @@
+package algorithms_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/algorithms"
+	"github.com/Delisa-sama/collections/graph"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := graph.NewDirectedGraph[int, int]()
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 1, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddEdge(5, 4, 1)
+	g.AddEdge(6, 5, 1)
+
+	components := algorithms.StronglyConnectedComponents(g.VerticesBegin(), g.VerticesEnd(), g.ForEachNeighbor)
+
+	assert.Len(t, components, 3)
+	assert.ElementsMatch(t, []int{4, 5}, components[0])
+	assert.ElementsMatch(t, []int{1, 2, 3}, components[1])
+	assert.ElementsMatch(t, []int{6}, components[2])
+}
@@ -295,14 +295,14 @@ func partitionLeft[T any](
 	if AdvanceCopy[T](last, 1).Equals(end) {
 		for first.Index() < last.Index() {
 			first.Next()
-			if !(cmp(pivot, first.Value())) {
+			if cmp(pivot, first.Value()) {
 				break
 			}
 		}
 	} else {
 		for {
 			first.Next()
-			if !(cmp(pivot, first.Value())) {
+			if cmp(pivot, first.Value()) {
 				break
 			}
 		}
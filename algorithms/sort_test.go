@@ -38,6 +38,18 @@ func TestSortC(t *testing.T) {
 	assert.Truef(t, Equals[int](vec.Begin(), expected.Begin()), "expected: %+v, got: %+v", expected, vec)
 }
 
+func TestSortManyDuplicates(t *testing.T) {
+	numbers := make([]int, 1024)
+	for i := range numbers {
+		numbers[i] = rand.Intn(8)
+	}
+	vec := vector.NewVectorFromSlice(slices.Clone(numbers))
+	Sort[int](vec.Begin(), vec.End())
+	slices.Sort(numbers)
+	expected := vector.NewVectorFromSlice(numbers)
+	assert.Truef(t, Equals[int](vec.Begin(), expected.Begin()), "expected: %+v, got: %+v", expected, vec)
+}
+
 const sortBenchmarkLength = 10_000
 
 func makeRandomIntSlice(n int) []int {
@@ -0,0 +1,86 @@
+package algorithms
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+)
+
+// Состояния вершины при обходе в глубину.
+const (
+	unvisited = iota
+	inProgress
+	finished
+)
+
+// TopologicalSort упорядочивает вершины ориентированного графа так, что каждое ребро ведет от более ранней вершины к более поздней.
+// Вершины и их соседи обходятся в обратном порядке, поэтому результат детерминирован,
+// а вершины графа без ребер возвращаются в порядке диапазона.
+// Если граф содержит цикл, возвращает ошибку *CycleError с вершинами найденного цикла.
+//
+// Параметры:
+// - begin: итератор на первую вершину графа.
+// - end: итератор на конец последовательности вершин.
+// - neighbors: функция перечисления исходящих ребер вершины.
+//
+// Возвращает:
+// - вершины в топологическом порядке, включая достижимые вершины, отсутствующие в диапазоне.
+// - ошибку *CycleError, если граф не ацикличен.
+func TopologicalSort[V comparable, W interfaces.Numeric](
+	begin interfaces.ForwardIterator[V],
+	end interfaces.Iterator,
+	neighbors Neighbors[V, W],
+) ([]V, error) {
+	state := make(map[V]int)
+	var (
+		order []V
+		path  []V // Вершины текущего пути обхода.
+		cycle []V
+	)
+
+	var visit func(v V)
+	visit = func(v V) {
+		state[v] = inProgress
+		path = append(path, v)
+		var adjacent []V
+		neighbors(v, func(to V, _ W) {
+			adjacent = append(adjacent, to)
+		})
+		for i := len(adjacent) - 1; i >= 0 && cycle == nil; i-- {
+			to := adjacent[i]
+			switch state[to] {
+			case unvisited:
+				visit(to)
+			case inProgress:
+				// Обратное ребро: цикл состоит из вершин пути начиная с to.
+				for j := len(path) - 1; j >= 0; j-- {
+					if path[j] == to {
+						cycle = append([]V(nil), path[j:]...)
+						break
+					}
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[v] = finished
+		order = append(order, v)
+	}
+
+	var vertices []V
+	for it := copiable.Copy[interfaces.ForwardIterator[V]](begin); !it.Equals(end); it.Next() {
+		vertices = append(vertices, it.Value())
+	}
+	for i := len(vertices) - 1; i >= 0; i-- {
+		if state[vertices[i]] == unvisited {
+			visit(vertices[i])
+		}
+		if cycle != nil {
+			return nil, &CycleError[V]{Cycle: cycle}
+		}
+	}
+
+	// Вершины добавлялись после всех своих потомков, поэтому порядок нужно развернуть.
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+	return order, nil
+}
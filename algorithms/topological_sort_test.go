@@ -0,0 +1,72 @@
+package algorithms_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/algorithms"
+	"github.com/Delisa-sama/collections/graph"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	g := graph.NewDirectedGraph[string, int]()
+	g.AddEdge("shirt", "tie", 1)
+	g.AddEdge("tie", "jacket", 1)
+	g.AddEdge("pants", "shoes", 1)
+	g.AddEdge("pants", "belt", 1)
+	g.AddEdge("belt", "jacket", 1)
+	g.AddEdge("socks", "shoes", 1)
+	g.AddVertex("watch")
+
+	order, err := algorithms.TopologicalSort(g.VerticesBegin(), g.VerticesEnd(), g.ForEachNeighbor)
+
+	assert.NoError(t, err)
+	assert.Len(t, order, 8)
+	position := make(map[string]int)
+	for i, v := range order {
+		position[v] = i
+	}
+	for it := g.VerticesBegin(); !it.Equals(g.VerticesEnd()); it.Next() {
+		g.ForEachNeighbor(it.Value(), func(to string, _ int) {
+			assert.Less(t, position[it.Value()], position[to])
+		})
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	g := graph.NewDirectedGraph[int, int]()
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 1, 1)
+
+	order, err := algorithms.TopologicalSort(g.VerticesBegin(), g.VerticesEnd(), g.ForEachNeighbor)
+
+	assert.Nil(t, order)
+	var cycleErr *algorithms.CycleError[int]
+	assert.True(t, errors.As(err, &cycleErr))
+	assert.Equal(t, []int{3, 1, 2}, cycleErr.Cycle)
+}
+
+func TestTopologicalSortOrder(t *testing.T) {
+	g := graph.NewDirectedGraph[string, int]()
+	g.AddVertex("c")
+	g.AddVertex("a")
+	g.AddVertex("b")
+
+	order, err := algorithms.TopologicalSort(g.VerticesBegin(), g.VerticesEnd(), g.ForEachNeighbor)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "a", "b"}, order)
+
+	g.AddEdge("b", "a", 1)
+	g.AddEdge("c", "d", 1)
+	g.AddEdge("c", "e", 1)
+
+	order, err = algorithms.TopologicalSort(g.VerticesBegin(), g.VerticesEnd(), g.ForEachNeighbor)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "b", "a", "d", "e"}, order)
+}
@@ -1,51 +1,96 @@
 package avltree
 
 import (
-	"github.com/Delisa-sama/collections/adapters/stack"
 	"github.com/Delisa-sama/collections/copiable"
 	"github.com/Delisa-sama/collections/interfaces"
 	"github.com/Delisa-sama/collections/iterators"
 	"github.com/Delisa-sama/collections/pair"
-	"github.com/Delisa-sama/collections/sequence/vector"
 )
 
-// inOrderIterator представляет итератор для in-order обхода AVL дерева.
+// inOrderIterator представляет итератор с произвольным доступом для in-order обхода AVL дерева.
+// Доступ по индексу и смещение выполняются за O(log n) благодаря хранению размеров поддеревьев.
+// Конечный итератор указывает на nil узел, из него можно вернуться к последнему элементу методом Prev.
 type inOrderIterator[K any, V any] struct {
+	tree    *AVLTree[K, V]
 	current *node[K, V]
-	s       *stack.Stack[*node[K, V], *vector.Vector[*node[K, V]]]
 }
 
-// newInOrderIterator создаёт новый inOrderIterator, устанавливая начальное состояние.
-func newInOrderIterator[K any, V any](root *node[K, V]) *inOrderIterator[K, V] {
-	it := &inOrderIterator[K, V]{
-		current: root,
-		s:       stack.NewStack(vector.NewVector[*node[K, V]]),
+// newInOrderIterator создаёт новый inOrderIterator, указывающий на узел n.
+func newInOrderIterator[K any, V any](tree *AVLTree[K, V], n *node[K, V]) *inOrderIterator[K, V] {
+	return &inOrderIterator[K, V]{
+		tree:    tree,
+		current: n,
 	}
-
-	it.pushLeft(root)
-	it.Next()
-	return it
 }
 
 // HasNext проверяет, есть ли ещё элементы для обхода.
 func (it *inOrderIterator[K, V]) HasNext() bool {
-	return !it.s.IsEmpty() || it.current != nil
+	return it.current != nil
 }
 
 // Next перемещает итератор к следующему элементу.
 func (it *inOrderIterator[K, V]) Next() {
-	if !it.s.IsEmpty() {
-		it.current = it.s.Top()
-		it.s.Pop()
-		it.pushLeft(it.current.Right)
-	} else {
-		it.current = nil
+	if it.current != nil {
+		it.current = it.current.next()
+	}
+}
+
+// HasPrev проверяет, есть ли предыдущий элемент.
+func (it *inOrderIterator[K, V]) HasPrev() bool {
+	if it.current == nil {
+		return it.tree.root != nil
+	}
+	return it.current.prev() != nil
+}
+
+// Prev перемещает итератор к предыдущему элементу.
+// Из конечного итератора переходит к последнему элементу дерева.
+func (it *inOrderIterator[K, V]) Prev() {
+	switch {
+	case it.current != nil:
+		it.current = it.current.prev()
+	case it.tree.root != nil:
+		it.current = it.tree.root.max()
 	}
 }
 
 // Value возвращает текущее значение узла.
 func (it *inOrderIterator[K, V]) Value() pair.Pair[K, V] {
-	return pair.NewPair(it.current.Key, it.current.Value)
+	return it.current.Entry
+}
+
+// Ptr возвращает указатель на текущее значение узла.
+// Изменение ключа через указатель нарушает упорядоченность дерева.
+func (it *inOrderIterator[K, V]) Ptr() *pair.Pair[K, V] {
+	return &it.current.Entry
+}
+
+// At возвращает указатель на значение элемента с заданной позицией в дереве.
+func (it *inOrderIterator[K, V]) At(index uint) (*pair.Pair[K, V], bool) {
+	n := it.tree.selectNode(index)
+	if n == nil {
+		return nil, false
+	}
+	return &n.Entry, true
+}
+
+// Shift смещает итератор на заданное количество элементов.
+// Если смещение положительное - смещает вперед, если отрицательное - назад.
+// При выходе за границы дерева итератор становится конечным.
+func (it *inOrderIterator[K, V]) Shift(offset int) {
+	newIndex := it.Index()
+	if offset < 0 {
+		newIndex -= uint(0 - offset)
+	} else {
+		newIndex += uint(offset)
+	}
+	it.current = it.tree.selectNode(newIndex)
+}
+
+// Index возвращает позицию текущего элемента в отсортированной последовательности.
+// Для конечного итератора возвращает размер дерева.
+func (it *inOrderIterator[K, V]) Index() uint {
+	return it.tree.index(it.current)
 }
 
 // Equals сравнивает два итератора на равенство.
@@ -54,23 +99,12 @@ func (it *inOrderIterator[K, V]) Equals(another interfaces.Iterator) bool {
 	case *inOrderIterator[K, V]:
 		return it.current == a.current
 	case *iterators.EndIterator:
-		return !it.HasNext()
+		return it.current == nil
 	}
 	panic("unknown iterator type")
 }
 
-// pushLeft добавляет в стек все левые узлы, начиная с заданного узла.
-func (it *inOrderIterator[K, V]) pushLeft(n *node[K, V]) {
-	for n != nil {
-		it.s.Push(n)
-		n = n.Left
-	}
-}
-
 // Copy копирует итератор.
 func (it *inOrderIterator[K, V]) Copy() copiable.Copiable {
-	return &inOrderIterator[K, V]{
-		current: it.current,
-		s:       it.s.Copy(),
-	}
+	return newInOrderIterator(it.tree, it.current)
 }
@@ -0,0 +1,96 @@
+package avltree
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/internal/levelorder"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// children возвращает потомков узла для level-order обхода.
+func (n *node[K, V]) children() (*node[K, V], *node[K, V]) {
+	return n.Left, n.Right
+}
+
+// levelOrderIterator представляет итератор для level-order (в ширину) обхода AVL дерева.
+type levelOrderIterator[K any, V any] struct {
+	w *levelorder.Walker[node[K, V]]
+}
+
+// newLevelOrderIterator создаёт новый levelOrderIterator, устанавливая начальное состояние.
+func newLevelOrderIterator[K any, V any](root *node[K, V]) *levelOrderIterator[K, V] {
+	return &levelOrderIterator[K, V]{w: levelorder.NewWalker(root, (*node[K, V]).children)}
+}
+
+// HasNext проверяет, есть ли ещё элементы для обхода.
+func (it *levelOrderIterator[K, V]) HasNext() bool {
+	return it.w.Node() != nil
+}
+
+// Next перемещает итератор к следующему элементу.
+func (it *levelOrderIterator[K, V]) Next() {
+	it.w.Next()
+}
+
+// Value возвращает текущее значение узла.
+func (it *levelOrderIterator[K, V]) Value() pair.Pair[K, V] {
+	return it.w.Node().Entry
+}
+
+// Equals сравнивает два итератора на равенство.
+func (it *levelOrderIterator[K, V]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *levelOrderIterator[K, V]:
+		return it.w.Node() == a.w.Node()
+	case *levelOrderDepthIterator[K, V]:
+		return it.w.Node() == a.it.w.Node()
+	case *iterators.EndIterator:
+		return it.w.Node() == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *levelOrderIterator[K, V]) Copy() copiable.Copiable {
+	return &levelOrderIterator[K, V]{w: copiable.Copy[*levelorder.Walker[node[K, V]]](it.w)}
+}
+
+// levelOrderDepthIterator представляет итератор для level-order обхода AVL дерева,
+// возвращающий вместе со значением глубину узла (глубина корня равна 0).
+type levelOrderDepthIterator[K any, V any] struct {
+	it *levelOrderIterator[K, V]
+}
+
+// newLevelOrderDepthIterator создаёт новый levelOrderDepthIterator.
+func newLevelOrderDepthIterator[K any, V any](root *node[K, V]) *levelOrderDepthIterator[K, V] {
+	return &levelOrderDepthIterator[K, V]{it: newLevelOrderIterator(root)}
+}
+
+// HasNext проверяет, есть ли ещё элементы для обхода.
+func (it *levelOrderDepthIterator[K, V]) HasNext() bool {
+	return it.it.HasNext()
+}
+
+// Next перемещает итератор к следующему элементу.
+func (it *levelOrderDepthIterator[K, V]) Next() {
+	it.it.Next()
+}
+
+// Value возвращает пару из текущего значения узла и его глубины.
+func (it *levelOrderDepthIterator[K, V]) Value() pair.Pair[pair.Pair[K, V], uint] {
+	return pair.NewPair(it.it.w.Node().Entry, it.it.w.Depth())
+}
+
+// Equals сравнивает два итератора на равенство.
+func (it *levelOrderDepthIterator[K, V]) Equals(another interfaces.Iterator) bool {
+	if a, ok := another.(*levelOrderDepthIterator[K, V]); ok {
+		return it.it.Equals(a.it)
+	}
+	return it.it.Equals(another)
+}
+
+// Copy копирует итератор.
+func (it *levelOrderDepthIterator[K, V]) Copy() copiable.Copiable {
+	return &levelOrderDepthIterator[K, V]{it: copiable.Copy[*levelOrderIterator[K, V]](it.it)}
+}
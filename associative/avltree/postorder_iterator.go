@@ -61,7 +61,7 @@ func (it *postOrderIterator[K, V]) Next() {
 
 // Value возвращает текущее значение узла.
 func (it *postOrderIterator[K, V]) Value() pair.Pair[K, V] {
-	return pair.NewPair(it.lastNodeVisited.Key, it.lastNodeVisited.Value)
+	return it.lastNodeVisited.Entry
 }
 
 // Equals сравнивает два итератора на равенство.
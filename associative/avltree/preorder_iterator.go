@@ -49,7 +49,7 @@ func (it *preOrderIterator[K, V]) Next() {
 
 // Value возвращает текущее значение узла.
 func (it *preOrderIterator[K, V]) Value() pair.Pair[K, V] {
-	return pair.NewPair(it.current.Key, it.current.Value)
+	return it.current.Entry
 }
 
 // Equals сравнивает два итератора на равенство.
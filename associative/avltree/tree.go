@@ -11,16 +11,72 @@ import (
 
 // node представляет узел AVL дерева
 type node[K any, V any] struct {
-	Key    K
-	Value  V
+	Entry  pair.Pair[K, V]
 	Height int
+	Size   uint // Количество узлов в поддереве, включая сам узел.
+	Parent *node[K, V]
 	Left   *node[K, V]
 	Right  *node[K, V]
 }
 
+// next возвращает следующий узел в in-order порядке.
+func (n *node[K, V]) next() *node[K, V] {
+	if n.Right != nil {
+		return n.Right.min()
+	}
+	current := n
+	parent := n.Parent
+	for parent != nil && current == parent.Right {
+		current = parent
+		parent = parent.Parent
+	}
+	return parent
+}
+
+// prev возвращает предыдущий узел в in-order порядке.
+func (n *node[K, V]) prev() *node[K, V] {
+	if n.Left != nil {
+		return n.Left.max()
+	}
+	current := n
+	parent := n.Parent
+	for parent != nil && current == parent.Left {
+		current = parent
+		parent = parent.Parent
+	}
+	return parent
+}
+
+// min возвращает минимальный узел поддерева.
+func (n *node[K, V]) min() *node[K, V] {
+	for n.Left != nil {
+		n = n.Left
+	}
+	return n
+}
+
+// max возвращает максимальный узел поддерева.
+func (n *node[K, V]) max() *node[K, V] {
+	for n.Right != nil {
+		n = n.Right
+	}
+	return n
+}
+
+// linkChildren проставляет узел родителем своих потомков.
+func (n *node[K, V]) linkChildren() {
+	if n.Left != nil {
+		n.Left.Parent = n
+	}
+	if n.Right != nil {
+		n.Right.Parent = n
+	}
+}
+
 // AVLTree представляет AVL дерево
 type AVLTree[K any, V any] struct {
 	root       *node[K, V]
+	size       uint
 	comparator comparator.Comparator[K]
 }
 
@@ -29,6 +85,22 @@ func NewAVLTree[K any, V any](comparator comparator.Comparator[K]) *AVLTree[K, V
 	return &AVLTree[K, V]{comparator: comparator}
 }
 
+// Size возвращает количество элементов в дереве.
+func (tree *AVLTree[K, V]) Size() uint {
+	return tree.size
+}
+
+// IsEmpty проверяет что дерево пустое.
+func (tree *AVLTree[K, V]) IsEmpty() bool {
+	return tree.size == 0
+}
+
+// Clear удаляет все элементы из дерева.
+func (tree *AVLTree[K, V]) Clear() {
+	tree.root = nil
+	tree.size = 0
+}
+
 // height возвращает высоту узла
 func (tree *AVLTree[K, V]) height(n *node[K, V]) int {
 	if n == nil {
@@ -37,6 +109,20 @@ func (tree *AVLTree[K, V]) height(n *node[K, V]) int {
 	return n.Height
 }
 
+// subtreeSize возвращает количество узлов в поддереве
+func (tree *AVLTree[K, V]) subtreeSize(n *node[K, V]) uint {
+	if n == nil {
+		return 0
+	}
+	return n.Size
+}
+
+// update пересчитывает высоту и размер поддерева узла
+func (tree *AVLTree[K, V]) update(n *node[K, V]) {
+	n.Height = max(tree.height(n.Left), tree.height(n.Right)) + 1
+	n.Size = tree.subtreeSize(n.Left) + tree.subtreeSize(n.Right) + 1
+}
+
 // rightRotate выполняет правое вращение
 func (tree *AVLTree[K, V]) rightRotate(y *node[K, V]) *node[K, V] {
 	x := y.Left
@@ -45,8 +131,12 @@ func (tree *AVLTree[K, V]) rightRotate(y *node[K, V]) *node[K, V] {
 	x.Right = y
 	y.Left = xRight
 
-	y.Height = max(tree.height(y.Left), tree.height(y.Right)) + 1
-	x.Height = max(tree.height(x.Left), tree.height(x.Right)) + 1
+	x.Parent = y.Parent
+	y.linkChildren()
+	x.linkChildren()
+
+	tree.update(y)
+	tree.update(x)
 
 	return x
 }
@@ -59,8 +149,12 @@ func (tree *AVLTree[K, V]) leftRotate(x *node[K, V]) *node[K, V] {
 	y.Left = x
 	x.Right = yLeft
 
-	x.Height = max(tree.height(x.Left), tree.height(x.Right)) + 1
-	y.Height = max(tree.height(y.Left), tree.height(y.Right)) + 1
+	y.Parent = x.Parent
+	x.linkChildren()
+	y.linkChildren()
+
+	tree.update(x)
+	tree.update(y)
 
 	return y
 }
@@ -76,51 +170,107 @@ func (tree *AVLTree[K, V]) getBalance(n *node[K, V]) int {
 // Insert вставляет новый ключ в дерево
 func (tree *AVLTree[K, V]) Insert(key K, value V) {
 	tree.root = tree.insert(tree.root, key, value)
+	tree.root.Parent = nil
 }
 
-// nolint:cyclop // допускается что внутренняя реализация контейнера может быть сложной ради оптимизации
 func (tree *AVLTree[K, V]) insert(n *node[K, V], key K, value V) *node[K, V] {
 	if n == nil {
-		return &node[K, V]{Key: key, Value: value, Height: 1}
+		tree.size++
+		return &node[K, V]{Entry: pair.NewPair(key, value), Height: 1, Size: 1}
 	}
 
-	switch {
-	case tree.comparator(key, n.Key) < 0:
+	switch c := tree.comparator(key, n.Entry.First); {
+	case c < 0:
 		n.Left = tree.insert(n.Left, key, value)
-	case tree.comparator(key, n.Key) > 0:
+	case c > 0:
 		n.Right = tree.insert(n.Right, key, value)
 	default:
-		n.Value = value
+		n.Entry.Second = value
 		return n
 	}
 
-	n.Height = 1 + max(tree.height(n.Left), tree.height(n.Right))
+	return tree.rebalance(n)
+}
+
+// rebalance пересчитывает высоту и размер поддерева узла и восстанавливает баланс поддерева при необходимости.
+// Возвращает новый корень поддерева.
+func (tree *AVLTree[K, V]) rebalance(n *node[K, V]) *node[K, V] {
+	n.linkChildren()
+	tree.update(n)
 
 	balance := tree.getBalance(n)
-
-	// Левый левый случай
-	if balance > 1 && tree.comparator(key, n.Left.Key) < 0 {
+	if balance > 1 {
+		// Левый правый случай сводится к левому левому
+		if tree.getBalance(n.Left) < 0 {
+			n.Left = tree.leftRotate(n.Left)
+		}
 		return tree.rightRotate(n)
 	}
-
-	// Правый правый случай
-	if balance < -1 && tree.comparator(key, n.Right.Key) > 0 {
+	if balance < -1 {
+		// Правый левый случай сводится к правому правому
+		if tree.getBalance(n.Right) > 0 {
+			n.Right = tree.rightRotate(n.Right)
+		}
 		return tree.leftRotate(n)
 	}
 
-	// Левый правый случай
-	if balance > 1 && tree.comparator(key, n.Left.Key) > 0 {
-		n.Left = tree.leftRotate(n.Left)
-		return tree.rightRotate(n)
+	return n
+}
+
+// Delete удаляет ключ из дерева.
+// Возвращает true в случае успешного удаления.
+func (tree *AVLTree[K, V]) Delete(key K) bool {
+	var deleted bool
+	tree.root, deleted = tree.delete(tree.root, key)
+	if deleted {
+		tree.size--
 	}
+	if tree.root != nil {
+		tree.root.Parent = nil
+	}
+	return deleted
+}
 
-	// Правый левый случай
-	if balance < -1 && tree.comparator(key, n.Right.Key) < 0 {
-		n.Right = tree.rightRotate(n.Right)
-		return tree.leftRotate(n)
+func (tree *AVLTree[K, V]) delete(n *node[K, V], key K) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
 	}
 
-	return n
+	var deleted bool
+	switch c := tree.comparator(key, n.Entry.First); {
+	case c < 0:
+		n.Left, deleted = tree.delete(n.Left, key)
+	case c > 0:
+		n.Right, deleted = tree.delete(n.Right, key)
+	default:
+		if n.Left == nil {
+			return n.Right, true
+		}
+		if n.Right == nil {
+			return n.Left, true
+		}
+		// Узел с двумя потомками заменяется минимальным узлом правого поддерева.
+		right, successor := tree.detachMin(n.Right)
+		successor.Left = n.Left
+		successor.Right = right
+		return tree.rebalance(successor), true
+	}
+
+	if !deleted {
+		return n, false
+	}
+	return tree.rebalance(n), true
+}
+
+// detachMin отсоединяет минимальный узел от поддерева.
+// Возвращает новый корень поддерева и отсоединенный узел.
+func (tree *AVLTree[K, V]) detachMin(n *node[K, V]) (*node[K, V], *node[K, V]) {
+	if n.Left == nil {
+		return n.Right, n
+	}
+	var m *node[K, V]
+	n.Left, m = tree.detachMin(n.Left)
+	return tree.rebalance(n), m
 }
 
 // Find ищет значение по ключу
@@ -130,28 +280,175 @@ func (tree *AVLTree[K, V]) Find(key K) (V, bool) {
 		var zero V
 		return zero, false
 	}
-	return n.Value, true
+	return n.Entry.Second, true
 }
 
 func (tree *AVLTree[K, V]) find(n *node[K, V], key K) *node[K, V] {
-	if n == nil || tree.comparator(key, n.Key) == 0 {
+	if n == nil || tree.comparator(key, n.Entry.First) == 0 {
 		return n
 	}
 
-	if tree.comparator(key, n.Key) < 0 {
+	if tree.comparator(key, n.Entry.First) < 0 {
 		return tree.find(n.Left, key)
 	}
 	return tree.find(n.Right, key)
 }
 
+// Rank возвращает количество ключей в дереве, строго меньших key,
+// то есть позицию key в отсортированной последовательности ключей.
+func (tree *AVLTree[K, V]) Rank(key K) uint {
+	var rank uint
+	for n := tree.root; n != nil; {
+		if tree.comparator(n.Entry.First, key) < 0 {
+			rank += tree.subtreeSize(n.Left) + 1
+			n = n.Right
+		} else {
+			n = n.Left
+		}
+	}
+	return rank
+}
+
+// Select возвращает ключ и значение i-го по порядку элемента дерева (нумерация с нуля).
+// Если i выходит за границы дерева, возвращает false.
+func (tree *AVLTree[K, V]) Select(i uint) (K, V, bool) {
+	n := tree.selectNode(i)
+	if n == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return n.Entry.First, n.Entry.Second, true
+}
+
+// selectNode возвращает i-й по порядку узел дерева или nil, если i выходит за границы дерева.
+func (tree *AVLTree[K, V]) selectNode(i uint) *node[K, V] {
+	n := tree.root
+	for n != nil {
+		leftSize := tree.subtreeSize(n.Left)
+		switch {
+		case i < leftSize:
+			n = n.Left
+		case i == leftSize:
+			return n
+		default:
+			i -= leftSize + 1
+			n = n.Right
+		}
+	}
+	return nil
+}
+
+// index возвращает позицию узла в in-order порядке. Для nil возвращает размер дерева.
+func (tree *AVLTree[K, V]) index(n *node[K, V]) uint {
+	if n == nil {
+		return tree.size
+	}
+	idx := tree.subtreeSize(n.Left)
+	for ; n.Parent != nil; n = n.Parent {
+		if n == n.Parent.Right {
+			idx += tree.subtreeSize(n.Parent.Left) + 1
+		}
+	}
+	return idx
+}
+
+// lowerBound возвращает первый узел, ключ которого не меньше key, или nil.
+func (tree *AVLTree[K, V]) lowerBound(key K) *node[K, V] {
+	var result *node[K, V]
+	for n := tree.root; n != nil; {
+		if tree.comparator(n.Entry.First, key) >= 0 {
+			result = n
+			n = n.Left
+		} else {
+			n = n.Right
+		}
+	}
+	return result
+}
+
+// upperBound возвращает первый узел, ключ которого больше key, или nil.
+func (tree *AVLTree[K, V]) upperBound(key K) *node[K, V] {
+	var result *node[K, V]
+	for n := tree.root; n != nil; {
+		if tree.comparator(n.Entry.First, key) > 0 {
+			result = n
+			n = n.Left
+		} else {
+			n = n.Right
+		}
+	}
+	return result
+}
+
+// Min возвращает итератор на элемент с минимальным ключом.
+// Если дерево пустое, возвращает конечный итератор.
+func (tree *AVLTree[K, V]) Min() interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return tree.InOrderBegin()
+}
+
+// Max возвращает итератор на элемент с максимальным ключом.
+// Если дерево пустое, возвращает конечный итератор.
+func (tree *AVLTree[K, V]) Max() interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	it := tree.InOrderEnd()
+	it.Prev()
+	return it
+}
+
+// LowerBound возвращает итератор на первый элемент, ключ которого не меньше key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (tree *AVLTree[K, V]) LowerBound(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return newInOrderIterator(tree, tree.lowerBound(key))
+}
+
+// UpperBound возвращает итератор на первый элемент, ключ которого больше key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (tree *AVLTree[K, V]) UpperBound(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return newInOrderIterator(tree, tree.upperBound(key))
+}
+
+// Ceiling возвращает итератор на элемент с наименьшим ключом, не меньшим key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (tree *AVLTree[K, V]) Ceiling(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return tree.LowerBound(key)
+}
+
+// Floor возвращает итератор на элемент с наибольшим ключом, не большим key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (tree *AVLTree[K, V]) Floor(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	// Элемент перед первым ключом, большим key, является искомым.
+	it := tree.UpperBound(key)
+	it.Prev()
+	return it
+}
+
+// Successor возвращает итератор на элемент, следующий за key, то есть с наименьшим ключом, большим key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (tree *AVLTree[K, V]) Successor(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return tree.UpperBound(key)
+}
+
+// Predecessor возвращает итератор на элемент, предшествующий key, то есть с наибольшим ключом, меньшим key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (tree *AVLTree[K, V]) Predecessor(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	it := tree.LowerBound(key)
+	it.Prev()
+	return it
+}
+
 // InOrderBegin возвращает итератор для in-order обхода с начала.
-func (tree *AVLTree[K, V]) InOrderBegin() interfaces.ValueIterator[pair.Pair[K, V]] {
-	return newInOrderIterator(tree.root)
+// Итератор поддерживает произвольный доступ по позиции элемента в отсортированной последовательности.
+func (tree *AVLTree[K, V]) InOrderBegin() interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	if tree.root == nil {
+		return newInOrderIterator[K, V](tree, nil)
+	}
+	return newInOrderIterator(tree, tree.root.min())
 }
 
 // InOrderEnd возвращает конечный итератор для in-order обхода.
-func (tree *AVLTree[K, V]) InOrderEnd() interfaces.Iterator {
-	return iterators.NewEndIterator()
+// Итератор можно сдвинуть назад методом Prev к последнему элементу дерева.
+func (tree *AVLTree[K, V]) InOrderEnd() interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return newInOrderIterator[K, V](tree, nil)
 }
 
 // PreOrderBegin возвращает итератор для pre-order обхода с начала.
@@ -174,6 +471,27 @@ func (tree *AVLTree[K, V]) PostOrderEnd() interfaces.Iterator {
 	return iterators.NewEndIterator()
 }
 
+// LevelOrderBegin возвращает итератор для level-order (в ширину) обхода с начала.
+func (tree *AVLTree[K, V]) LevelOrderBegin() interfaces.ValueIterator[pair.Pair[K, V]] {
+	return newLevelOrderIterator(tree.root)
+}
+
+// LevelOrderEnd возвращает конечный итератор для level-order обхода.
+func (tree *AVLTree[K, V]) LevelOrderEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// LevelOrderDepthBegin возвращает итератор для level-order обхода с начала,
+// возвращающий пары из элемента узла и его глубины. Глубина корня равна 0.
+func (tree *AVLTree[K, V]) LevelOrderDepthBegin() interfaces.ValueIterator[pair.Pair[pair.Pair[K, V], uint]] {
+	return newLevelOrderDepthIterator(tree.root)
+}
+
+// LevelOrderDepthEnd возвращает конечный итератор для level-order обхода с глубиной.
+func (tree *AVLTree[K, V]) LevelOrderDepthEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
 // Copy копирует дерево.
 func (tree *AVLTree[K, V]) Copy() copiable.Copiable {
 	treeCopy := NewAVLTree[K, V](tree.comparator)
@@ -0,0 +1,191 @@
+package avltree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Delisa-sama/collections/comparator"
+)
+
+// checkInvariants проверяет сбалансированность дерева, сохраненные высоты и размеры поддеревьев,
+// связи с родителями и упорядоченность ключей.
+func checkInvariants(t *testing.T, tree *AVLTree[int, int]) {
+	t.Helper()
+	if tree.root == nil {
+		require.Zero(t, tree.size)
+		return
+	}
+	require.Nil(t, tree.root.Parent)
+
+	var walk func(n *node[int, int], lo, hi *int) (int, uint)
+	walk = func(n *node[int, int], lo, hi *int) (int, uint) {
+		if n == nil {
+			return 0, 0
+		}
+		if lo != nil {
+			require.Less(t, *lo, n.Entry.First)
+		}
+		if hi != nil {
+			require.Less(t, n.Entry.First, *hi)
+		}
+		if n.Left != nil {
+			require.Same(t, n, n.Left.Parent)
+		}
+		if n.Right != nil {
+			require.Same(t, n, n.Right.Parent)
+		}
+		leftHeight, leftSize := walk(n.Left, lo, &n.Entry.First)
+		rightHeight, rightSize := walk(n.Right, &n.Entry.First, hi)
+		require.LessOrEqual(t, leftHeight-rightHeight, 1, "node %d is unbalanced", n.Entry.First)
+		require.GreaterOrEqual(t, leftHeight-rightHeight, -1, "node %d is unbalanced", n.Entry.First)
+		require.Equal(t, max(leftHeight, rightHeight)+1, n.Height, "node %d", n.Entry.First)
+		require.Equal(t, leftSize+rightSize+1, n.Size, "node %d", n.Entry.First)
+		return n.Height, n.Size
+	}
+	_, size := walk(tree.root, nil, nil)
+	require.Equal(t, tree.Size(), size)
+}
+
+// sortedKeys возвращает ключи отображения-модели по возрастанию.
+func sortedKeys(model map[int]int) []int {
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// checkModel сравнивает содержимое дерева с отображением-моделью.
+func checkModel(t *testing.T, tree *AVLTree[int, int], model map[int]int) {
+	t.Helper()
+	keys := sortedKeys(model)
+	require.Equal(t, uint(len(model)), tree.Size())
+	require.Equal(t, len(model) == 0, tree.IsEmpty())
+	i := 0
+	for it := tree.InOrderBegin(); !it.Equals(tree.InOrderEnd()); it.Next() {
+		require.Equal(t, keys[i], it.Value().First)
+		require.Equal(t, model[keys[i]], it.Value().Second)
+		i++
+	}
+	require.Equal(t, len(keys), i)
+}
+
+func TestRandomInsertDelete(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tree := NewAVLTree[int, int](comparator.DefaultComparator[int]())
+	model := make(map[int]int)
+
+	for step := 0; step < 5000; step++ {
+		key := rnd.Intn(500)
+		// Сначала дерево в основном растет, затем в основном сокращается.
+		if rnd.Intn(5000) < 5000-step {
+			value := rnd.Int()
+			tree.Insert(key, value)
+			model[key] = value
+		} else {
+			_, ok := model[key]
+			require.Equal(t, ok, tree.Delete(key))
+			delete(model, key)
+		}
+
+		value, ok := tree.Find(key)
+		expected, expectedOk := model[key]
+		require.Equal(t, expectedOk, ok)
+		require.Equal(t, expected, value)
+		require.Equal(t, uint(len(model)), tree.Size())
+
+		if step%10 == 0 {
+			checkInvariants(t, tree)
+			checkModel(t, tree, model)
+		}
+	}
+	for i, key := range sortedKeys(model) {
+		require.True(t, tree.Delete(key))
+		delete(model, key)
+		if i%10 == 0 {
+			checkInvariants(t, tree)
+		}
+	}
+	checkModel(t, tree, model)
+	assert.False(t, tree.Delete(0))
+}
+
+func TestSequentialDelete(t *testing.T) {
+	tree := NewAVLTree[int, int](comparator.DefaultComparator[int]())
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i, i)
+	}
+	checkInvariants(t, tree)
+	for i := 0; i < 1000; i += 2 {
+		require.True(t, tree.Delete(i))
+		if i%50 == 0 {
+			checkInvariants(t, tree)
+		}
+	}
+	checkInvariants(t, tree)
+	assert.Equal(t, uint(500), tree.Size())
+
+	tree.Clear()
+	assert.True(t, tree.IsEmpty())
+	assert.True(t, tree.InOrderBegin().Equals(tree.InOrderEnd()))
+}
+
+func TestRankSelectIndexAfterDeletes(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	tree := NewAVLTree[int, int](comparator.DefaultComparator[int]())
+	model := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		key := rnd.Intn(2000)
+		tree.Insert(key, i)
+		model[key] = i
+	}
+
+	for round := 0; round < 20; round++ {
+		for i := 0; i < 30; i++ {
+			key := rnd.Intn(2000)
+			_, ok := model[key]
+			require.Equal(t, ok, tree.Delete(key))
+			delete(model, key)
+		}
+		keys := sortedKeys(model)
+
+		for i, key := range keys {
+			k, v, ok := tree.Select(uint(i))
+			require.True(t, ok)
+			require.Equal(t, key, k)
+			require.Equal(t, model[key], v)
+			require.Equal(t, uint(i), tree.Rank(k))
+		}
+		_, _, ok := tree.Select(uint(len(keys)))
+		require.False(t, ok)
+
+		// Rank отсутствующего ключа равен количеству меньших ключей.
+		for j := 0; j < 50; j++ {
+			key := rnd.Intn(2100) - 50
+			require.Equal(t, uint(sort.SearchInts(keys, key)), tree.Rank(key), key)
+		}
+
+		i := uint(0)
+		for it := tree.InOrderBegin(); !it.Equals(tree.InOrderEnd()); it.Next() {
+			require.Equal(t, i, it.Index())
+			require.Equal(t, keys[i], it.Value().First)
+			i++
+		}
+		require.Equal(t, uint(len(keys)), i)
+		require.Equal(t, uint(len(keys)), tree.InOrderEnd().Index())
+
+		// Произвольный доступ через итератор согласован с Select.
+		it := tree.InOrderBegin()
+		for j := 0; j < 20 && len(keys) > 0; j++ {
+			idx := uint(rnd.Intn(len(keys)))
+			p, ok := it.At(idx)
+			require.True(t, ok)
+			require.Equal(t, keys[idx], p.First)
+		}
+	}
+}
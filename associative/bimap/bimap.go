@@ -0,0 +1,199 @@
+package bimap
+
+import (
+	"errors"
+
+	"github.com/elliotchance/orderedmap/v2"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// ConflictPolicy определяет поведение Put при конфликте с существующими парами.
+type ConflictPolicy uint8
+
+const (
+	// ConflictError - Put не изменяет отображение и возвращает ошибку.
+	ConflictError ConflictPolicy = iota
+	// ConflictOverwrite - конфликтующие пары удаляются, новая пара вставляется.
+	ConflictOverwrite
+	// ConflictKeep - существующие пары сохраняются, новая пара не вставляется.
+	ConflictKeep
+)
+
+var (
+	// ErrKeyExists возвращается при вставке ключа, уже связанного с другим значением.
+	ErrKeyExists = errors.New("bimap: key is already mapped to another value")
+	// ErrValueExists возвращается при вставке значения, уже связанного с другим ключом.
+	ErrValueExists = errors.New("bimap: value is already mapped to another key")
+)
+
+// BiMap представляет собой двунаправленное отображение, в котором уникальны и ключи, и значения.
+// Поиск и удаление выполняются как по ключу, так и по значению. Элементы перебираются в порядке вставки.
+type BiMap[K comparable, V comparable] struct {
+	forward  *orderedmap.OrderedMap[K, V]
+	backward map[V]K
+	policy   ConflictPolicy
+}
+
+// NewBiMap создает новое двунаправленное отображение с заданной политикой разрешения конфликтов.
+func NewBiMap[K comparable, V comparable](policy ConflictPolicy) *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:  orderedmap.NewOrderedMap[K, V](),
+		backward: make(map[V]K),
+		policy:   policy,
+	}
+}
+
+// Policy возвращает политику разрешения конфликтов.
+func (m *BiMap[K, V]) Policy() ConflictPolicy {
+	return m.policy
+}
+
+// Size возвращает количество пар в отображении.
+func (m *BiMap[K, V]) Size() uint {
+	return uint(m.forward.Len())
+}
+
+// IsEmpty проверяет что отображение пустое.
+func (m *BiMap[K, V]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Clear удаляет все пары из отображения.
+func (m *BiMap[K, V]) Clear() {
+	m.forward = orderedmap.NewOrderedMap[K, V]()
+	clear(m.backward)
+}
+
+// Put связывает ключ key со значением value.
+// Если ключ связан с другим значением или значение связано с другим ключом, поведение определяется политикой:
+// ConflictError возвращает ErrKeyExists или ErrValueExists, ConflictOverwrite удаляет конфликтующие пары,
+// ConflictKeep оставляет отображение без изменений.
+// Возвращает true, если пара была вставлена.
+func (m *BiMap[K, V]) Put(key K, value V) (bool, error) {
+	oldValue, keyFound := m.forward.Get(key)
+	oldKey, valueFound := m.backward[value]
+	if keyFound && valueFound && oldValue == value {
+		// Пара уже существует.
+		return false, nil
+	}
+
+	if keyFound || valueFound {
+		switch m.policy {
+		case ConflictError:
+			if keyFound {
+				return false, ErrKeyExists
+			}
+			return false, ErrValueExists
+		case ConflictKeep:
+			return false, nil
+		}
+		if keyFound {
+			m.forward.Delete(key)
+			delete(m.backward, oldValue)
+		}
+		if valueFound {
+			m.forward.Delete(oldKey)
+			delete(m.backward, value)
+		}
+	}
+
+	m.forward.Set(key, value)
+	m.backward[value] = key
+	return true, nil
+}
+
+// GetByKey возвращает значение, связанное с ключом, и признак его наличия.
+func (m *BiMap[K, V]) GetByKey(key K) (V, bool) {
+	return m.forward.Get(key)
+}
+
+// GetByValue возвращает ключ, связанный со значением, и признак его наличия.
+func (m *BiMap[K, V]) GetByValue(value V) (K, bool) {
+	key, found := m.backward[value]
+	return key, found
+}
+
+// ContainsKey проверяет есть ли ключ в отображении.
+func (m *BiMap[K, V]) ContainsKey(key K) bool {
+	_, found := m.forward.Get(key)
+	return found
+}
+
+// ContainsValue проверяет есть ли значение в отображении.
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, found := m.backward[value]
+	return found
+}
+
+// DeleteByKey удаляет пару с ключом key.
+// Возвращает true в случае успешного удаления.
+func (m *BiMap[K, V]) DeleteByKey(key K) bool {
+	value, found := m.forward.Get(key)
+	if !found {
+		return false
+	}
+	m.forward.Delete(key)
+	delete(m.backward, value)
+	return true
+}
+
+// DeleteByValue удаляет пару со значением value.
+// Возвращает true в случае успешного удаления.
+func (m *BiMap[K, V]) DeleteByValue(value V) bool {
+	key, found := m.backward[value]
+	if !found {
+		return false
+	}
+	m.forward.Delete(key)
+	delete(m.backward, value)
+	return true
+}
+
+// Inverse возвращает новое отображение, в котором ключи и значения поменяны местами.
+// Порядок перебора и политика разрешения конфликтов сохраняются.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	inverse := NewBiMap[V, K](m.policy)
+	for el := m.forward.Front(); el != nil; el = el.Next() {
+		inverse.forward.Set(el.Value, el.Key)
+		inverse.backward[el.Key] = el.Value
+	}
+	return inverse
+}
+
+// Begin возвращает итератор на первую вставленную пару.
+func (m *BiMap[K, V]) Begin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return newIterator(m.forward, m.forward.Front())
+}
+
+// End возвращает итератор на элемент после последнего.
+// Итератор можно сдвинуть назад методом Prev к последней паре.
+func (m *BiMap[K, V]) End() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return newIterator[K, V](m.forward, nil)
+}
+
+// RBegin возвращает перевернутый итератор на последнюю вставленную пару.
+func (m *BiMap[K, V]) RBegin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return iterators.NewReverseIterator[pair.Pair[K, V]](newIterator(m.forward, m.forward.Back()))
+}
+
+// REnd возвращает итератор на конец перевернутого отображения.
+func (m *BiMap[K, V]) REnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию отображения.
+func (m *BiMap[K, V]) Copy() copiable.Copiable {
+	backward := make(map[V]K, len(m.backward))
+	for v, k := range m.backward {
+		backward[v] = k
+	}
+	return &BiMap[K, V]{
+		forward:  m.forward.Copy(),
+		backward: backward,
+		policy:   m.policy,
+	}
+}
@@ -0,0 +1,140 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// pairs собирает пары отображения в порядке перебора.
+func pairs[K comparable, V comparable](m *BiMap[K, V]) []pair.Pair[K, V] {
+	var result []pair.Pair[K, V]
+	for it := m.Begin(); !it.Equals(m.End()); it.Next() {
+		result = append(result, it.Value())
+	}
+	return result
+}
+
+// checkConsistent проверяет, что прямое и обратное отображения согласованы.
+func checkConsistent[K comparable, V comparable](t *testing.T, m *BiMap[K, V]) {
+	t.Helper()
+	assert.Len(t, m.backward, int(m.Size()))
+	for _, p := range pairs(m) {
+		key, found := m.GetByValue(p.Second)
+		assert.True(t, found)
+		assert.Equal(t, p.First, key)
+	}
+}
+
+// newFilled создает отображение с парами 1-a и 2-b.
+func newFilled(policy ConflictPolicy) *BiMap[int, string] {
+	m := NewBiMap[int, string](policy)
+	_, _ = m.Put(1, "a")
+	_, _ = m.Put(2, "b")
+	return m
+}
+
+func TestPutExistingPair(t *testing.T) {
+	for _, policy := range []ConflictPolicy{ConflictError, ConflictOverwrite, ConflictKeep} {
+		m := newFilled(policy)
+		inserted, err := m.Put(1, "a")
+		assert.NoError(t, err)
+		assert.False(t, inserted)
+		assert.Equal(t, []pair.Pair[int, string]{pair.NewPair(1, "a"), pair.NewPair(2, "b")}, pairs(m))
+	}
+}
+
+func TestConflictError(t *testing.T) {
+	m := newFilled(ConflictError)
+	before := pairs(m)
+
+	inserted, err := m.Put(1, "c")
+	assert.False(t, inserted)
+	assert.ErrorIs(t, err, ErrKeyExists)
+
+	inserted, err = m.Put(3, "a")
+	assert.False(t, inserted)
+	assert.ErrorIs(t, err, ErrValueExists)
+
+	// Ключ и значение конфликтуют с разными парами.
+	inserted, err = m.Put(1, "b")
+	assert.False(t, inserted)
+	assert.ErrorIs(t, err, ErrKeyExists)
+
+	assert.Equal(t, before, pairs(m))
+	checkConsistent(t, m)
+
+	inserted, err = m.Put(3, "c")
+	assert.True(t, inserted)
+	assert.NoError(t, err)
+}
+
+func TestConflictOverwrite(t *testing.T) {
+	m := newFilled(ConflictOverwrite)
+
+	inserted, err := m.Put(1, "c")
+	assert.True(t, inserted)
+	assert.NoError(t, err)
+	assert.False(t, m.ContainsValue("a"))
+	assert.Equal(t, []pair.Pair[int, string]{pair.NewPair(2, "b"), pair.NewPair(1, "c")}, pairs(m))
+	checkConsistent(t, m)
+
+	inserted, err = m.Put(3, "b")
+	assert.True(t, inserted)
+	assert.NoError(t, err)
+	assert.False(t, m.ContainsKey(2))
+	assert.Equal(t, []pair.Pair[int, string]{pair.NewPair(1, "c"), pair.NewPair(3, "b")}, pairs(m))
+	checkConsistent(t, m)
+
+	// Ключ и значение конфликтуют с разными парами: обе пары удаляются.
+	inserted, err = m.Put(1, "b")
+	assert.True(t, inserted)
+	assert.NoError(t, err)
+	assert.Equal(t, []pair.Pair[int, string]{pair.NewPair(1, "b")}, pairs(m))
+	assert.False(t, m.ContainsKey(3))
+	assert.False(t, m.ContainsValue("c"))
+	checkConsistent(t, m)
+}
+
+func TestConflictKeep(t *testing.T) {
+	m := newFilled(ConflictKeep)
+	before := pairs(m)
+
+	for _, p := range []pair.Pair[int, string]{pair.NewPair(1, "c"), pair.NewPair(3, "a"), pair.NewPair(1, "b")} {
+		inserted, err := m.Put(p.First, p.Second)
+		assert.False(t, inserted)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, before, pairs(m))
+	checkConsistent(t, m)
+}
+
+func TestDeleteAndInverse(t *testing.T) {
+	m := newFilled(ConflictError)
+	_, _ = m.Put(3, "c")
+
+	assert.True(t, m.DeleteByValue("b"))
+	assert.False(t, m.DeleteByValue("b"))
+	assert.True(t, m.DeleteByKey(1))
+	assert.False(t, m.DeleteByKey(1))
+	assert.Equal(t, []pair.Pair[int, string]{pair.NewPair(3, "c")}, pairs(m))
+	checkConsistent(t, m)
+
+	inverse := m.Inverse()
+	key, found := inverse.GetByKey("c")
+	assert.True(t, found)
+	assert.Equal(t, 3, key)
+	checkConsistent(t, inverse)
+}
+
+func TestIteratorNextOnEnd(t *testing.T) {
+	m := newFilled(ConflictError)
+
+	it := m.End()
+	it.Next()
+	assert.True(t, it.Equals(m.End()))
+	it.Prev()
+	assert.Equal(t, pair.NewPair(2, "b"), it.Value())
+}
@@ -0,0 +1,86 @@
+package bimap
+
+import (
+	"github.com/elliotchance/orderedmap/v2"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// iterator представляет собой двунаправленный итератор по парам отображения в порядке вставки.
+type iterator[K comparable, V comparable] struct {
+	m       *orderedmap.OrderedMap[K, V]
+	current *orderedmap.Element[K, V]
+}
+
+// newIterator создает новый итератор. Значение nil соответствует концу отображения.
+func newIterator[K comparable, V comparable](
+	m *orderedmap.OrderedMap[K, V],
+	current *orderedmap.Element[K, V],
+) *iterator[K, V] {
+	return &iterator[K, V]{
+		m:       m,
+		current: current,
+	}
+}
+
+// HasNext проверяет, указывает ли итератор на пару отображения.
+func (it *iterator[K, V]) HasNext() bool {
+	return it.current != nil
+}
+
+// Next переходит к следующей паре.
+// Для итератора, указывающего на конец отображения, ничего не делает.
+func (it *iterator[K, V]) Next() {
+	if it.current != nil {
+		it.current = it.current.Next()
+	}
+}
+
+// HasPrev проверяет, есть ли предыдущая пара.
+func (it *iterator[K, V]) HasPrev() bool {
+	if it.current == nil {
+		return it.m.Len() > 0
+	}
+	return it.current.Prev() != nil
+}
+
+// Prev переходит к предыдущей паре.
+// Переход назад от конца отображения перемещает итератор на последнюю пару.
+func (it *iterator[K, V]) Prev() {
+	if it.current == nil {
+		it.current = it.m.Back()
+		return
+	}
+	it.current = it.current.Prev()
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator[K, V]) Value() pair.Pair[K, V] {
+	return pair.NewPair(it.current.Key, it.current.Value)
+}
+
+// Ptr возвращает указатель на копию текущей пары.
+// Изменение через указатель не затрагивает отображение, иначе нарушилась бы уникальность ключей и значений.
+func (it *iterator[K, V]) Ptr() *pair.Pair[K, V] {
+	p := it.Value()
+	return &p
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *iterator[K, V]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[K, V]:
+		return it.current == a.current
+	case *iterators.EndIterator:
+		return it.current == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *iterator[K, V]) Copy() copiable.Copiable {
+	return newIterator(it.m, it.current)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/Delisa-sama/collections/copiable"
 	"github.com/Delisa-sama/collections/interfaces"
 	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
 )
 
 // node представляет узел в бинарном поисковом дереве (BST).
@@ -29,6 +30,19 @@ func (n *node[T]) next() *node[T] {
 	return parent
 }
 
+func (n *node[T]) prev() *node[T] {
+	if n.Left != nil {
+		return n.Left.max()
+	}
+	parent := n.Parent
+	current := n
+	for parent != nil && current == parent.Left {
+		current = parent
+		parent = parent.Parent
+	}
+	return parent
+}
+
 func (n *node[T]) min() *node[T] {
 	if n.Left == nil {
 		return n
@@ -212,12 +226,28 @@ func (t *BST[T]) Max() T {
 }
 
 // InOrderBegin возвращает итератор для in-order обхода с начала.
-func (t *BST[T]) InOrderBegin() interfaces.ForwardIterator[T] {
-	return newInOrderIterator(t.root)
+func (t *BST[T]) InOrderBegin() interfaces.BidirectionalIterator[T] {
+	if t.root == nil {
+		return newInOrderIterator[T](t, nil)
+	}
+	return newInOrderIterator(t, t.root.min())
 }
 
 // InOrderEnd возвращает конечный итератор для in-order обхода.
-func (t *BST[T]) InOrderEnd() interfaces.Iterator {
+// Итератор можно сдвинуть назад методом Prev к последнему элементу дерева.
+func (t *BST[T]) InOrderEnd() interfaces.BidirectionalIterator[T] {
+	return newInOrderIterator[T](t, nil)
+}
+
+// RBegin возвращает перевернутый итератор на последний элемент in-order обхода.
+func (t *BST[T]) RBegin() interfaces.BidirectionalIterator[T] {
+	it := t.InOrderEnd()
+	it.Prev()
+	return iterators.NewReverseIterator(it)
+}
+
+// REnd возвращает конечный итератор для обратного in-order обхода.
+func (t *BST[T]) REnd() interfaces.Iterator {
 	return iterators.NewEndIterator()
 }
 
@@ -241,6 +271,27 @@ func (t *BST[T]) PostOrderEnd() interfaces.Iterator {
 	return iterators.NewEndIterator()
 }
 
+// LevelOrderBegin возвращает итератор для level-order (в ширину) обхода с начала.
+func (t *BST[T]) LevelOrderBegin() interfaces.ForwardIterator[T] {
+	return newLevelOrderIterator(t.root)
+}
+
+// LevelOrderEnd возвращает конечный итератор для level-order обхода.
+func (t *BST[T]) LevelOrderEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// LevelOrderDepthBegin возвращает итератор для level-order обхода с начала,
+// возвращающий пары из значения узла и его глубины. Глубина корня равна 0.
+func (t *BST[T]) LevelOrderDepthBegin() interfaces.ValueIterator[pair.Pair[T, uint]] {
+	return newLevelOrderDepthIterator(t.root)
+}
+
+// LevelOrderDepthEnd возвращает конечный итератор для level-order обхода с глубиной.
+func (t *BST[T]) LevelOrderDepthEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
 // Copy копирует дерево.
 func (t *BST[T]) Copy() copiable.Copiable {
 	bstCopy := NewBST[T](t.comp)
@@ -1,44 +1,54 @@
 package bst
 
 import (
-	"github.com/Delisa-sama/collections/adapters/stack"
 	"github.com/Delisa-sama/collections/copiable"
 	"github.com/Delisa-sama/collections/interfaces"
 	"github.com/Delisa-sama/collections/iterators"
-	"github.com/Delisa-sama/collections/sequence/vector"
 )
 
-// inOrderIterator представляет итератор для in-order обхода BST.
+// inOrderIterator представляет двунаправленный итератор для in-order обхода BST.
+// Конечный итератор указывает на nil узел, из него можно вернуться к последнему элементу методом Prev.
 type inOrderIterator[T any] struct {
+	tree    *BST[T]
 	current *node[T]
-	s       *stack.Stack[*node[T], *vector.Vector[*node[T]]]
 }
 
-// newInOrderIterator создаёт новый inOrderIterator, устанавливая начальное состояние.
-func newInOrderIterator[T any](root *node[T]) *inOrderIterator[T] {
-	it := &inOrderIterator[T]{
-		current: root,
-		s:       stack.NewStack(vector.NewVector[*node[T]]),
+// newInOrderIterator создаёт новый inOrderIterator, указывающий на узел n.
+func newInOrderIterator[T any](tree *BST[T], n *node[T]) *inOrderIterator[T] {
+	return &inOrderIterator[T]{
+		tree:    tree,
+		current: n,
 	}
-
-	it.pushLeft(root)
-	it.Next()
-	return it
 }
 
 // HasNext проверяет, есть ли ещё элементы для обхода.
 func (it *inOrderIterator[T]) HasNext() bool {
-	return !it.s.IsEmpty() || it.current != nil
+	return it.current != nil
 }
 
 // Next перемещает итератор к следующему элементу.
 func (it *inOrderIterator[T]) Next() {
-	if !it.s.IsEmpty() {
-		it.current = it.s.Top()
-		it.s.Pop()
-		it.pushLeft(it.current.Right)
-	} else {
-		it.current = nil
+	if it.current != nil {
+		it.current = it.current.next()
+	}
+}
+
+// HasPrev проверяет, есть ли предыдущий элемент.
+func (it *inOrderIterator[T]) HasPrev() bool {
+	if it.current == nil {
+		return it.tree.root != nil
+	}
+	return it.current.prev() != nil
+}
+
+// Prev перемещает итератор к предыдущему элементу.
+// Из конечного итератора переходит к последнему элементу дерева.
+func (it *inOrderIterator[T]) Prev() {
+	switch {
+	case it.current != nil:
+		it.current = it.current.prev()
+	case it.tree.root != nil:
+		it.current = it.tree.root.max()
 	}
 }
 
@@ -58,23 +68,12 @@ func (it *inOrderIterator[T]) Equals(another interfaces.Iterator) bool {
 	case *inOrderIterator[T]:
 		return it.current == a.current
 	case *iterators.EndIterator:
-		return !it.HasNext()
+		return it.current == nil
 	}
 	panic("unknown iterator type")
 }
 
-// pushLeft добавляет в стек все левые узлы, начиная с заданного узла.
-func (it *inOrderIterator[T]) pushLeft(n *node[T]) {
-	for n != nil {
-		it.s.Push(n)
-		n = n.Left
-	}
-}
-
 // Copy копирует итератор.
 func (it *inOrderIterator[T]) Copy() copiable.Copiable {
-	return &inOrderIterator[T]{
-		current: it.current,
-		s:       it.s.Copy(),
-	}
+	return newInOrderIterator(it.tree, it.current)
 }
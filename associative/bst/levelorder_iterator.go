@@ -0,0 +1,101 @@
+package bst
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/internal/levelorder"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// children возвращает потомков узла для level-order обхода.
+func (n *node[T]) children() (*node[T], *node[T]) {
+	return n.Left, n.Right
+}
+
+// levelOrderIterator представляет итератор для level-order (в ширину) обхода BST.
+type levelOrderIterator[T any] struct {
+	w *levelorder.Walker[node[T]]
+}
+
+// newLevelOrderIterator создаёт новый levelOrderIterator, устанавливая начальное состояние.
+func newLevelOrderIterator[T any](root *node[T]) *levelOrderIterator[T] {
+	return &levelOrderIterator[T]{w: levelorder.NewWalker(root, (*node[T]).children)}
+}
+
+// HasNext проверяет, есть ли ещё элементы для обхода.
+func (it *levelOrderIterator[T]) HasNext() bool {
+	return it.w.Node() != nil
+}
+
+// Next перемещает итератор к следующему элементу.
+func (it *levelOrderIterator[T]) Next() {
+	it.w.Next()
+}
+
+// Value возвращает текущее значение узла.
+func (it *levelOrderIterator[T]) Value() T {
+	return it.w.Node().Value
+}
+
+// Ptr возвращает указатель на текущее значение узла.
+func (it *levelOrderIterator[T]) Ptr() *T {
+	return &it.w.Node().Value
+}
+
+// Equals сравнивает два итератора на равенство.
+func (it *levelOrderIterator[T]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *levelOrderIterator[T]:
+		return it.w.Node() == a.w.Node()
+	case *levelOrderDepthIterator[T]:
+		return it.w.Node() == a.it.w.Node()
+	case *iterators.EndIterator:
+		return it.w.Node() == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *levelOrderIterator[T]) Copy() copiable.Copiable {
+	return &levelOrderIterator[T]{w: copiable.Copy[*levelorder.Walker[node[T]]](it.w)}
+}
+
+// levelOrderDepthIterator представляет итератор для level-order обхода BST,
+// возвращающий вместе со значением глубину узла (глубина корня равна 0).
+type levelOrderDepthIterator[T any] struct {
+	it *levelOrderIterator[T]
+}
+
+// newLevelOrderDepthIterator создаёт новый levelOrderDepthIterator.
+func newLevelOrderDepthIterator[T any](root *node[T]) *levelOrderDepthIterator[T] {
+	return &levelOrderDepthIterator[T]{it: newLevelOrderIterator(root)}
+}
+
+// HasNext проверяет, есть ли ещё элементы для обхода.
+func (it *levelOrderDepthIterator[T]) HasNext() bool {
+	return it.it.HasNext()
+}
+
+// Next перемещает итератор к следующему элементу.
+func (it *levelOrderDepthIterator[T]) Next() {
+	it.it.Next()
+}
+
+// Value возвращает пару из текущего значения узла и его глубины.
+func (it *levelOrderDepthIterator[T]) Value() pair.Pair[T, uint] {
+	return pair.NewPair(it.it.w.Node().Value, it.it.w.Depth())
+}
+
+// Equals сравнивает два итератора на равенство.
+func (it *levelOrderDepthIterator[T]) Equals(another interfaces.Iterator) bool {
+	if a, ok := another.(*levelOrderDepthIterator[T]); ok {
+		return it.it.Equals(a.it)
+	}
+	return it.it.Equals(another)
+}
+
+// Copy копирует итератор.
+func (it *levelOrderDepthIterator[T]) Copy() copiable.Copiable {
+	return &levelOrderDepthIterator[T]{it: copiable.Copy[*levelOrderIterator[T]](it.it)}
+}
@@ -0,0 +1,512 @@
+package btree
+
+import (
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+const (
+	// DefaultDegree - минимальная степень дерева по умолчанию.
+	DefaultDegree = 32
+
+	// MinDegree - наименьшая допустимая минимальная степень дерева.
+	MinDegree = 2
+)
+
+// node представляет узел B-дерева.
+// Элементы узла хранятся в одном слайсе, что уменьшает количество переходов по указателям при поиске.
+type node[K any, V any] struct {
+	Entries  []pair.Pair[K, V]
+	Children []*node[K, V] // Пустой слайс у листьев, иначе на один элемент больше, чем Entries.
+}
+
+// isLeaf проверяет, что узел является листом.
+func (n *node[K, V]) isLeaf() bool {
+	return len(n.Children) == 0
+}
+
+// BTree представляет собой упорядоченное отображение ключей в значения на основе B-дерева.
+// Каждый узел, кроме корня, хранит от degree-1 до 2*degree-1 элементов, где degree - минимальная степень дерева.
+type BTree[K any, V any] struct {
+	root   *node[K, V]
+	size   uint
+	degree int
+	comp   comparator.Comparator[K]
+}
+
+// NewBTree создает новое B-дерево с заданной минимальной степенью и компаратором ключей
+// и заполняет его переданными парами. Если степень меньше MinDegree, возникает паника.
+func NewBTree[K any, V any](degree int, comp comparator.Comparator[K], items ...pair.Pair[K, V]) *BTree[K, V] {
+	if degree < MinDegree {
+		panic("btree degree must be at least 2")
+	}
+	t := &BTree[K, V]{
+		degree: degree,
+		comp:   comp,
+	}
+	for i := range items {
+		t.Put(items[i].First, items[i].Second)
+	}
+	return t
+}
+
+// NewBTreeFromSorted создает новое B-дерево с заданной минимальной степенью и компаратором ключей
+// из диапазона [begin, end), упорядоченного по возрастанию ключей. Для равных ключей сохраняется последнее значение.
+// Дерево строится снизу вверх за линейное время без поиска и разбиения узлов.
+// Если диапазон не упорядочен или степень меньше MinDegree, возникает паника.
+func NewBTreeFromSorted[K any, V any](
+	degree int,
+	comp comparator.Comparator[K],
+	begin interfaces.ForwardIterator[pair.Pair[K, V]],
+	end interfaces.Iterator,
+) *BTree[K, V] {
+	t := NewBTree[K, V](degree, comp)
+
+	var entries []pair.Pair[K, V]
+	for it := copiable.Copy[interfaces.ForwardIterator[pair.Pair[K, V]]](begin); !it.Equals(end); it.Next() {
+		entry := it.Value()
+		if n := len(entries); n > 0 {
+			c := comp(entries[n-1].First, entry.First)
+			if c > 0 {
+				panic("btree bulk load range is not sorted")
+			}
+			if c == 0 {
+				entries[n-1] = entry
+				continue
+			}
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) == 0 {
+		return t
+	}
+
+	height := 1
+	for t.maxEntries(height) < len(entries) {
+		height++
+	}
+	t.root = t.build(entries, height)
+	t.size = uint(len(entries))
+	return t
+}
+
+// maxEntries возвращает максимальное количество элементов в поддереве заданной высоты.
+func (t *BTree[K, V]) maxEntries(height int) int {
+	capacity := 1
+	for i := 0; i < height; i++ {
+		capacity *= 2 * t.degree
+	}
+	return capacity - 1
+}
+
+// build строит поддерево заданной высоты из упорядоченных элементов.
+// Элементы распределяются между потомками поровну, поэтому каждый потомок заполнен не менее чем наполовину.
+func (t *BTree[K, V]) build(entries []pair.Pair[K, V], height int) *node[K, V] {
+	if height == 1 {
+		n := t.newNode(false)
+		n.Entries = append(n.Entries, entries...)
+		return n
+	}
+
+	childCapacity := t.maxEntries(height - 1)
+	count := max(2, (len(entries)+childCapacity+1)/(childCapacity+1))
+	total := len(entries) - (count - 1)
+	base, extra := total/count, total%count
+
+	n := t.newNode(true)
+	for i := 0; i < count; i++ {
+		size := base
+		if i < extra {
+			size++
+		}
+		n.Children = append(n.Children, t.build(entries[:size], height-1))
+		entries = entries[size:]
+		if i < count-1 {
+			n.Entries = append(n.Entries, entries[0])
+			entries = entries[1:]
+		}
+	}
+	return n
+}
+
+// newNode создает узел с памятью под максимальное количество элементов.
+func (t *BTree[K, V]) newNode(internal bool) *node[K, V] {
+	n := &node[K, V]{Entries: make([]pair.Pair[K, V], 0, 2*t.degree-1)}
+	if internal {
+		n.Children = make([]*node[K, V], 0, 2*t.degree)
+	}
+	return n
+}
+
+// Degree возвращает минимальную степень дерева.
+func (t *BTree[K, V]) Degree() int {
+	return t.degree
+}
+
+// Size возвращает количество элементов в дереве.
+func (t *BTree[K, V]) Size() uint {
+	return t.size
+}
+
+// IsEmpty проверяет что дерево пустое.
+func (t *BTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear удаляет все элементы из дерева.
+func (t *BTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// search возвращает индекс первого элемента узла, ключ которого не меньше key, и признак равенства ключей.
+func (t *BTree[K, V]) search(n *node[K, V], key K) (int, bool) {
+	lo, hi := 0, len(n.Entries)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if t.comp(n.Entries[mid].First, key) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(n.Entries) && t.comp(n.Entries[lo].First, key) == 0
+}
+
+// find возвращает узел и индекс элемента с ключом key или nil, если ключ не найден.
+func (t *BTree[K, V]) find(key K) (*node[K, V], int) {
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found {
+			return n, i
+		}
+		if n.isLeaf() {
+			break
+		}
+		n = n.Children[i]
+	}
+	return nil, 0
+}
+
+// Get возвращает значение по ключу и признак его наличия.
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	n, i := t.find(key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	return n.Entries[i].Second, true
+}
+
+// Contains проверяет есть ли ключ в дереве.
+func (t *BTree[K, V]) Contains(key K) bool {
+	n, _ := t.find(key)
+	return n != nil
+}
+
+// Put добавляет пару ключ-значение в дерево. Если ключ уже существует, его значение заменяется.
+func (t *BTree[K, V]) Put(key K, value V) {
+	if t.root == nil {
+		t.root = t.newNode(false)
+	}
+	if len(t.root.Entries) == 2*t.degree-1 {
+		// Корень заполнен: дерево растет вверх.
+		root := t.newNode(true)
+		root.Children = append(root.Children, t.root)
+		t.splitChild(root, 0)
+		t.root = root
+	}
+
+	// Заполненные узлы разбиваются на пути вниз, поэтому вставка в лист всегда возможна.
+	n := t.root
+	for {
+		i, found := t.search(n, key)
+		if found {
+			n.Entries[i].Second = value
+			return
+		}
+		if n.isLeaf() {
+			n.Entries = insertAt(n.Entries, i, pair.NewPair(key, value))
+			t.size++
+			return
+		}
+		if len(n.Children[i].Entries) == 2*t.degree-1 {
+			t.splitChild(n, i)
+			if c := t.comp(key, n.Entries[i].First); c == 0 {
+				n.Entries[i].Second = value
+				return
+			} else if c > 0 {
+				i++
+			}
+		}
+		n = n.Children[i]
+	}
+}
+
+// splitChild разбивает заполненного потомка i узла n на два, поднимая средний элемент в n.
+func (t *BTree[K, V]) splitChild(n *node[K, V], i int) {
+	child := n.Children[i]
+	mid := t.degree - 1
+
+	right := t.newNode(!child.isLeaf())
+	right.Entries = append(right.Entries, child.Entries[mid+1:]...)
+	if !child.isLeaf() {
+		right.Children = append(right.Children, child.Children[mid+1:]...)
+		clear(child.Children[mid+1:])
+		child.Children = child.Children[:mid+1]
+	}
+	median := child.Entries[mid]
+	clear(child.Entries[mid:])
+	child.Entries = child.Entries[:mid]
+
+	n.Entries = insertAt(n.Entries, i, median)
+	n.Children = insertAt(n.Children, i+1, right)
+}
+
+// Delete удаляет ключ из дерева.
+// Возвращает true в случае успешного удаления.
+func (t *BTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+	deleted := t.delete(t.root, key)
+	if len(t.root.Entries) == 0 {
+		// Корень опустел: дерево уменьшается в высоту.
+		if t.root.isLeaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.Children[0]
+		}
+	}
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// delete удаляет ключ из поддерева n. Перед спуском в потомка гарантирует,
+// что в нем не меньше degree элементов, поэтому удаление из листа не нарушает свойств дерева.
+func (t *BTree[K, V]) delete(n *node[K, V], key K) bool {
+	for {
+		i, found := t.search(n, key)
+		if n.isLeaf() {
+			if !found {
+				return false
+			}
+			n.Entries = removeAt(n.Entries, i)
+			return true
+		}
+
+		if found {
+			left, right := n.Children[i], n.Children[i+1]
+			switch {
+			case len(left.Entries) >= t.degree:
+				// Заменяем элемент предшественником и удаляем предшественника из левого поддерева.
+				pred := t.maxEntry(left)
+				n.Entries[i] = pred
+				n, key = left, pred.First
+			case len(right.Entries) >= t.degree:
+				// Заменяем элемент последователем и удаляем последователя из правого поддерева.
+				succ := t.minEntry(right)
+				n.Entries[i] = succ
+				n, key = right, succ.First
+			default:
+				t.merge(n, i)
+				n = left
+			}
+			continue
+		}
+
+		if len(n.Children[i].Entries) < t.degree {
+			i = t.fill(n, i)
+		}
+		n = n.Children[i]
+	}
+}
+
+// fill дополняет потомка i узла n до degree элементов за счет соседа или слиянием с ним.
+// Возвращает индекс потомка, в котором оказались элементы исходного потомка.
+func (t *BTree[K, V]) fill(n *node[K, V], i int) int {
+	child := n.Children[i]
+	switch {
+	case i > 0 && len(n.Children[i-1].Entries) >= t.degree:
+		// Занимаем элемент у левого соседа через родителя.
+		left := n.Children[i-1]
+		child.Entries = insertAt(child.Entries, 0, n.Entries[i-1])
+		n.Entries[i-1] = left.Entries[len(left.Entries)-1]
+		left.Entries = removeAt(left.Entries, len(left.Entries)-1)
+		if !left.isLeaf() {
+			child.Children = insertAt(child.Children, 0, left.Children[len(left.Children)-1])
+			left.Children = removeAt(left.Children, len(left.Children)-1)
+		}
+		return i
+	case i < len(n.Children)-1 && len(n.Children[i+1].Entries) >= t.degree:
+		// Занимаем элемент у правого соседа через родителя.
+		right := n.Children[i+1]
+		child.Entries = append(child.Entries, n.Entries[i])
+		n.Entries[i] = right.Entries[0]
+		right.Entries = removeAt(right.Entries, 0)
+		if !right.isLeaf() {
+			child.Children = append(child.Children, right.Children[0])
+			right.Children = removeAt(right.Children, 0)
+		}
+		return i
+	case i < len(n.Children)-1:
+		t.merge(n, i)
+		return i
+	default:
+		t.merge(n, i-1)
+		return i - 1
+	}
+}
+
+// merge сливает потомков i и i+1 узла n вместе с разделяющим их элементом в потомка i.
+func (t *BTree[K, V]) merge(n *node[K, V], i int) {
+	left, right := n.Children[i], n.Children[i+1]
+	left.Entries = append(left.Entries, n.Entries[i])
+	left.Entries = append(left.Entries, right.Entries...)
+	left.Children = append(left.Children, right.Children...)
+	n.Entries = removeAt(n.Entries, i)
+	n.Children = removeAt(n.Children, i+1)
+}
+
+// minEntry возвращает элемент с минимальным ключом поддерева.
+func (t *BTree[K, V]) minEntry(n *node[K, V]) pair.Pair[K, V] {
+	for !n.isLeaf() {
+		n = n.Children[0]
+	}
+	return n.Entries[0]
+}
+
+// maxEntry возвращает элемент с максимальным ключом поддерева.
+func (t *BTree[K, V]) maxEntry(n *node[K, V]) pair.Pair[K, V] {
+	for !n.isLeaf() {
+		n = n.Children[len(n.Children)-1]
+	}
+	return n.Entries[len(n.Entries)-1]
+}
+
+// insertAt вставляет значение в слайс в позицию i.
+func insertAt[T any](s []T, i int, v T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+// removeAt удаляет элемент слайса в позиции i, обнуляя освободившуюся ячейку.
+func removeAt[T any](s []T, i int) []T {
+	copy(s[i:], s[i+1:])
+	var zero T
+	s[len(s)-1] = zero
+	return s[:len(s)-1]
+}
+
+// bound возвращает итератор на первый элемент, ключ которого больше key, если strict,
+// или не меньше key в противном случае.
+func (t *BTree[K, V]) bound(key K, strict bool) *iterator[K, V] {
+	it := newIterator(t)
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found && strict {
+			i++
+		}
+		it.path = append(it.path, frame[K, V]{n: n, i: i})
+		if (found && !strict) || n.isLeaf() {
+			break
+		}
+		n = n.Children[i]
+	}
+	it.climb()
+	return it
+}
+
+// Find возвращает итератор на элемент с заданным ключом.
+// Если ключ не найден, возвращает конечный итератор.
+func (t *BTree[K, V]) Find(key K) interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	it := t.bound(key, false)
+	if it.isEnd() || t.comp(it.Value().First, key) != 0 {
+		return newIterator(t)
+	}
+	return it
+}
+
+// LowerBound возвращает итератор на первый элемент, ключ которого не меньше key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (t *BTree[K, V]) LowerBound(key K) interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return t.bound(key, false)
+}
+
+// UpperBound возвращает итератор на первый элемент, ключ которого больше key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (t *BTree[K, V]) UpperBound(key K) interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return t.bound(key, true)
+}
+
+// Range возвращает пару итераторов [begin, end) на элементы, ключи которых лежат в диапазоне [from, to).
+func (t *BTree[K, V]) Range(from, to K) (
+	interfaces.BidirectionalIterator[pair.Pair[K, V]],
+	interfaces.BidirectionalIterator[pair.Pair[K, V]],
+) {
+	begin := t.LowerBound(from)
+	if t.comp(from, to) >= 0 {
+		return begin, copiable.Copy[interfaces.BidirectionalIterator[pair.Pair[K, V]]](begin)
+	}
+	return begin, t.LowerBound(to)
+}
+
+// Begin возвращает итератор на элемент с минимальным ключом.
+func (t *BTree[K, V]) Begin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	it := newIterator(t)
+	if t.root != nil {
+		it.descendLeft(t.root)
+	}
+	return it
+}
+
+// End возвращает итератор на элемент после последнего.
+// Итератор можно сдвинуть назад методом Prev к последнему элементу.
+func (t *BTree[K, V]) End() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return newIterator(t)
+}
+
+// RBegin возвращает перевернутый итератор на элемент с максимальным ключом.
+func (t *BTree[K, V]) RBegin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	it := newIterator(t)
+	if t.root != nil {
+		it.descendRight(t.root)
+	}
+	return iterators.NewReverseIterator[pair.Pair[K, V]](it)
+}
+
+// REnd возвращает итератор на конец перевернутого дерева.
+func (t *BTree[K, V]) REnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию дерева.
+func (t *BTree[K, V]) Copy() copiable.Copiable {
+	return &BTree[K, V]{
+		root:   t.cloneNode(t.root),
+		size:   t.size,
+		degree: t.degree,
+		comp:   t.comp,
+	}
+}
+
+// cloneNode рекурсивно копирует поддерево.
+func (t *BTree[K, V]) cloneNode(n *node[K, V]) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	c := t.newNode(!n.isLeaf())
+	c.Entries = append(c.Entries, n.Entries...)
+	for _, child := range n.Children {
+		c.Children = append(c.Children, t.cloneNode(child))
+	}
+	return c
+}
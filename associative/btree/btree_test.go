@@ -0,0 +1,146 @@
+package btree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Delisa-sama/collections/comparator"
+)
+
+// checkInvariants проверяет заполненность узлов, упорядоченность ключей и одинаковую глубину листьев.
+func checkInvariants(t *testing.T, tree *BTree[int, int]) {
+	t.Helper()
+	if tree.root == nil {
+		require.Zero(t, tree.size)
+		return
+	}
+	leafDepth := -1
+	var count uint
+	var walk func(n *node[int, int], depth int, lo, hi *int)
+	walk = func(n *node[int, int], depth int, lo, hi *int) {
+		if n != tree.root {
+			require.GreaterOrEqual(t, len(n.Entries), tree.degree-1)
+		}
+		require.LessOrEqual(t, len(n.Entries), 2*tree.degree-1)
+		require.NotEmpty(t, n.Entries)
+		for i, e := range n.Entries {
+			if i > 0 {
+				require.Less(t, n.Entries[i-1].First, e.First)
+			}
+			if lo != nil {
+				require.Less(t, *lo, e.First)
+			}
+			if hi != nil {
+				require.Less(t, e.First, *hi)
+			}
+		}
+		count += uint(len(n.Entries))
+		if n.isLeaf() {
+			if leafDepth < 0 {
+				leafDepth = depth
+			}
+			require.Equal(t, leafDepth, depth)
+			return
+		}
+		require.Len(t, n.Children, len(n.Entries)+1)
+		for i, child := range n.Children {
+			childLo, childHi := lo, hi
+			if i > 0 {
+				childLo = &n.Entries[i-1].First
+			}
+			if i < len(n.Entries) {
+				childHi = &n.Entries[i].First
+			}
+			walk(child, depth+1, childLo, childHi)
+		}
+	}
+	walk(tree.root, 0, nil, nil)
+	require.Equal(t, tree.size, count)
+}
+
+// checkModel сравнивает содержимое дерева с отображением-моделью в прямом и обратном порядке.
+func checkModel(t *testing.T, tree *BTree[int, int], model map[int]int) {
+	t.Helper()
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	require.Equal(t, uint(len(model)), tree.Size())
+	i := 0
+	for it := tree.Begin(); !it.Equals(tree.End()); it.Next() {
+		require.Equal(t, keys[i], it.Value().First)
+		require.Equal(t, model[keys[i]], it.Value().Second)
+		i++
+	}
+	require.Equal(t, len(keys), i)
+
+	it := tree.End()
+	for i = len(keys) - 1; i >= 0; i-- {
+		require.True(t, it.HasPrev())
+		it.Prev()
+		require.Equal(t, keys[i], it.Value().First)
+	}
+	require.False(t, it.HasPrev())
+}
+
+func TestRandomOperations(t *testing.T) {
+	for _, degree := range []int{MinDegree, 3, 5} {
+		rnd := rand.New(rand.NewSource(int64(degree)))
+		tree := NewBTree[int, int](degree, comparator.DefaultComparator[int]())
+		model := make(map[int]int)
+
+		for step := 0; step < 5000; step++ {
+			key := rnd.Intn(300)
+			// Удаления чуть чаще вставок, чтобы дерево регулярно уменьшалось и узлы сливались.
+			if rnd.Intn(100) < 45 {
+				value := rnd.Int()
+				tree.Put(key, value)
+				model[key] = value
+			} else {
+				_, ok := model[key]
+				assert.Equal(t, ok, tree.Delete(key))
+				delete(model, key)
+			}
+
+			value, ok := tree.Get(key)
+			expected, expectedOk := model[key]
+			require.Equal(t, expectedOk, ok)
+			require.Equal(t, expected, value)
+			if step%10 == 0 {
+				checkInvariants(t, tree)
+			}
+			if step%100 == 0 {
+				checkModel(t, tree, model)
+			}
+		}
+		checkModel(t, tree, model)
+	}
+}
+
+func TestDeleteAllInRandomOrder(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tree := NewBTree[int, int](MinDegree, comparator.DefaultComparator[int]())
+	model := make(map[int]int)
+	for _, key := range rnd.Perm(1000) {
+		tree.Put(key, -key)
+		model[key] = -key
+	}
+	checkInvariants(t, tree)
+
+	for _, key := range rnd.Perm(1000) {
+		require.True(t, tree.Delete(key))
+		delete(model, key)
+		if key%10 == 0 {
+			checkInvariants(t, tree)
+		}
+	}
+	checkModel(t, tree, model)
+	assert.True(t, tree.IsEmpty())
+	assert.True(t, tree.Begin().Equals(tree.End()))
+}
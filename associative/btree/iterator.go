@@ -0,0 +1,167 @@
+package btree
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// frame представляет собой шаг пути от корня к текущему элементу.
+// Для последнего шага i - индекс текущего элемента узла, для остальных - индекс потомка, в который выполнен спуск.
+type frame[K any, V any] struct {
+	n *node[K, V]
+	i int
+}
+
+// iterator представляет собой двунаправленный итератор по элементам B-дерева в порядке возрастания ключей.
+// Хранит путь от корня, поэтому не требует ссылок на родителей в узлах.
+// Изменение дерева инвалидирует все итераторы.
+type iterator[K any, V any] struct {
+	tree *BTree[K, V]
+	path []frame[K, V] // Пустой путь соответствует концу дерева.
+}
+
+// newIterator создает новый итератор, указывающий на конец дерева.
+func newIterator[K any, V any](tree *BTree[K, V]) *iterator[K, V] {
+	return &iterator[K, V]{tree: tree}
+}
+
+// isEnd проверяет, указывает ли итератор на конец дерева.
+func (it *iterator[K, V]) isEnd() bool {
+	return len(it.path) == 0
+}
+
+// top возвращает последний шаг пути.
+func (it *iterator[K, V]) top() *frame[K, V] {
+	return &it.path[len(it.path)-1]
+}
+
+// descendLeft спускается от узла n к минимальному элементу его поддерева.
+func (it *iterator[K, V]) descendLeft(n *node[K, V]) {
+	for {
+		it.path = append(it.path, frame[K, V]{n: n, i: 0})
+		if n.isLeaf() {
+			return
+		}
+		n = n.Children[0]
+	}
+}
+
+// descendRight спускается от узла n к максимальному элементу его поддерева.
+func (it *iterator[K, V]) descendRight(n *node[K, V]) {
+	for !n.isLeaf() {
+		it.path = append(it.path, frame[K, V]{n: n, i: len(n.Children) - 1})
+		n = n.Children[len(n.Children)-1]
+	}
+	it.path = append(it.path, frame[K, V]{n: n, i: len(n.Entries) - 1})
+}
+
+// climb поднимается по пути, пока последний шаг указывает за пределы элементов узла.
+// Используется после спуска по границе поддерева и при переходе вперед из листа.
+func (it *iterator[K, V]) climb() {
+	for !it.isEnd() && it.top().i >= len(it.top().n.Entries) {
+		it.path = it.path[:len(it.path)-1]
+	}
+}
+
+// HasNext проверяет, указывает ли итератор на элемент дерева.
+func (it *iterator[K, V]) HasNext() bool {
+	return !it.isEnd()
+}
+
+// Next переходит к следующему элементу.
+// Для итератора, указывающего на конец дерева, ничего не делает.
+func (it *iterator[K, V]) Next() {
+	if it.isEnd() {
+		return
+	}
+	top := it.top()
+	top.i++
+	if !top.n.isLeaf() {
+		it.descendLeft(top.n.Children[top.i])
+		return
+	}
+	it.climb()
+}
+
+// HasPrev проверяет, есть ли предыдущий элемент.
+func (it *iterator[K, V]) HasPrev() bool {
+	if it.isEnd() {
+		return it.tree.size > 0
+	}
+	top := it.top()
+	if !top.n.isLeaf() || top.i > 0 {
+		return true
+	}
+	for i := len(it.path) - 2; i >= 0; i-- {
+		if it.path[i].i > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Prev переходит к предыдущему элементу.
+// Переход назад от конца дерева перемещает итератор на последний элемент.
+// Для пустого дерева итератор остается на конце.
+func (it *iterator[K, V]) Prev() {
+	if it.isEnd() {
+		if it.tree.root == nil {
+			return
+		}
+		it.descendRight(it.tree.root)
+		return
+	}
+	top := it.top()
+	if !top.n.isLeaf() {
+		it.descendRight(top.n.Children[top.i])
+		return
+	}
+	top.i--
+	for top.i < 0 {
+		it.path = it.path[:len(it.path)-1]
+		if it.isEnd() {
+			return
+		}
+		top = it.top()
+		top.i--
+	}
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator[K, V]) Value() pair.Pair[K, V] {
+	top := it.top()
+	return top.n.Entries[top.i]
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+// Изменение ключа через указатель нарушает упорядоченность дерева.
+func (it *iterator[K, V]) Ptr() *pair.Pair[K, V] {
+	top := it.top()
+	return &top.n.Entries[top.i]
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *iterator[K, V]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[K, V]:
+		if it.isEnd() || a.isEnd() {
+			return it.isEnd() && a.isEnd()
+		}
+		return *it.top() == *a.top()
+	case *iterators.EndIterator:
+		return it.isEnd()
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *iterator[K, V]) Copy() copiable.Copiable {
+	path := make([]frame[K, V], len(it.path))
+	copy(path, it.path)
+	return &iterator[K, V]{
+		tree: it.tree,
+		path: path,
+	}
+}
@@ -0,0 +1,29 @@
+package btree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/comparator"
+)
+
+func TestIteratorPrevOnEmptyTree(t *testing.T) {
+	tree := NewBTree[int, int](MinDegree, comparator.DefaultComparator[int]())
+
+	it := tree.End()
+	assert.False(t, it.HasPrev())
+	it.Prev()
+	assert.True(t, it.Equals(tree.End()))
+}
+
+func TestIteratorNextOnEnd(t *testing.T) {
+	tree := NewBTree[int, int](MinDegree, comparator.DefaultComparator[int]())
+	tree.Put(1, 1)
+
+	it := tree.End()
+	it.Next()
+	assert.True(t, it.Equals(tree.End()))
+	it.Prev()
+	assert.Equal(t, 1, it.Value().First)
+}
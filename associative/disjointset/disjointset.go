@@ -0,0 +1,187 @@
+package disjointset
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// DisjointSet представляет собой систему непересекающихся множеств (union-find)
+// с объединением по рангу и сжатием путей. Амортизированная сложность операций - O(α(n)),
+// где α - обратная функция Аккермана.
+// Элементы каждого множества связаны в кольцевой список, что позволяет перебрать множество за время, пропорциональное его размеру.
+type DisjointSet[T comparable] struct {
+	index  map[T]int
+	values []T
+	parent []int
+	rank   []uint8
+	size   []uint // Размер множества, актуален только для представителей.
+	next   []int  // Следующий элемент кольцевого списка множества.
+	count  uint
+}
+
+// NewDisjointSet создает новую систему множеств, в которой каждый из переданных элементов образует отдельное множество.
+func NewDisjointSet[T comparable](items ...T) *DisjointSet[T] {
+	ds := &DisjointSet[T]{index: make(map[T]int, len(items))}
+	for _, item := range items {
+		ds.Add(item)
+	}
+	return ds
+}
+
+// Size возвращает количество элементов во всех множествах.
+func (ds *DisjointSet[T]) Size() uint {
+	return uint(len(ds.values))
+}
+
+// IsEmpty проверяет что система не содержит элементов.
+func (ds *DisjointSet[T]) IsEmpty() bool {
+	return len(ds.values) == 0
+}
+
+// Count возвращает количество множеств.
+func (ds *DisjointSet[T]) Count() uint {
+	return ds.count
+}
+
+// Contains проверяет есть ли элемент в системе.
+func (ds *DisjointSet[T]) Contains(x T) bool {
+	_, found := ds.index[x]
+	return found
+}
+
+// Add добавляет элемент как отдельное множество.
+// Возвращает false, если элемент уже есть в системе.
+func (ds *DisjointSet[T]) Add(x T) bool {
+	if _, found := ds.index[x]; found {
+		return false
+	}
+	i := len(ds.values)
+	ds.index[x] = i
+	ds.values = append(ds.values, x)
+	ds.parent = append(ds.parent, i)
+	ds.rank = append(ds.rank, 0)
+	ds.size = append(ds.size, 1)
+	ds.next = append(ds.next, i)
+	ds.count++
+	return true
+}
+
+// id возвращает номер элемента, добавляя его при отсутствии.
+func (ds *DisjointSet[T]) id(x T) int {
+	ds.Add(x)
+	return ds.index[x]
+}
+
+// root возвращает номер представителя множества, содержащего элемент i.
+// Каждый пройденный элемент подвешивается к своему деду (сжатие путей делением пополам).
+func (ds *DisjointSet[T]) root(i int) int {
+	for ds.parent[i] != i {
+		ds.parent[i] = ds.parent[ds.parent[i]]
+		i = ds.parent[i]
+	}
+	return i
+}
+
+// Find возвращает представителя множества, содержащего x, и признак наличия x в системе.
+func (ds *DisjointSet[T]) Find(x T) (T, bool) {
+	i, found := ds.index[x]
+	if !found {
+		var zero T
+		return zero, false
+	}
+	return ds.values[ds.root(i)], true
+}
+
+// Union объединяет множества, содержащие a и b. Отсутствующие элементы предварительно добавляются.
+// Возвращает true, если множества были различны и объединены.
+func (ds *DisjointSet[T]) Union(a, b T) bool {
+	ra, rb := ds.root(ds.id(a)), ds.root(ds.id(b))
+	if ra == rb {
+		return false
+	}
+	// Дерево меньшего ранга подвешивается к дереву большего ранга.
+	if ds.rank[ra] < ds.rank[rb] {
+		ra, rb = rb, ra
+	}
+	ds.parent[rb] = ra
+	if ds.rank[ra] == ds.rank[rb] {
+		ds.rank[ra]++
+	}
+	ds.size[ra] += ds.size[rb]
+	// Обмен следующих элементов сливает два кольцевых списка в один.
+	ds.next[ra], ds.next[rb] = ds.next[rb], ds.next[ra]
+	ds.count--
+	return true
+}
+
+// Connected проверяет, принадлежат ли a и b одному множеству.
+// Если хотя бы одного из элементов нет в системе, возвращает false.
+func (ds *DisjointSet[T]) Connected(a, b T) bool {
+	ia, foundA := ds.index[a]
+	ib, foundB := ds.index[b]
+	return foundA && foundB && ds.root(ia) == ds.root(ib)
+}
+
+// SetSize возвращает размер множества, содержащего x. Если x нет в системе, возвращает 0.
+func (ds *DisjointSet[T]) SetSize(x T) uint {
+	i, found := ds.index[x]
+	if !found {
+		return 0
+	}
+	return ds.size[ds.root(i)]
+}
+
+// Clear удаляет все элементы из системы.
+func (ds *DisjointSet[T]) Clear() {
+	clear(ds.index)
+	ds.values = nil
+	ds.parent = nil
+	ds.rank = nil
+	ds.size = nil
+	ds.next = nil
+	ds.count = 0
+}
+
+// MembersBegin возвращает итератор по элементам множества, содержащего x, начиная с x.
+// Если x нет в системе, возвращает конечный итератор.
+func (ds *DisjointSet[T]) MembersBegin(x T) interfaces.ForwardIterator[T] {
+	i, found := ds.index[x]
+	if !found {
+		return newMembersIterator(ds, end)
+	}
+	return newMembersIterator(ds, i)
+}
+
+// MembersEnd возвращает итератор на конец множества.
+func (ds *DisjointSet[T]) MembersEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// RepresentativesBegin возвращает итератор по представителям всех множеств в порядке добавления элементов.
+// Вместе с MembersBegin позволяет перебрать элементы каждого множества.
+func (ds *DisjointSet[T]) RepresentativesBegin() interfaces.ForwardIterator[T] {
+	return newRepresentativesIterator(ds)
+}
+
+// RepresentativesEnd возвращает итератор на конец последовательности представителей.
+func (ds *DisjointSet[T]) RepresentativesEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию системы множеств.
+func (ds *DisjointSet[T]) Copy() copiable.Copiable {
+	index := make(map[T]int, len(ds.index))
+	for k, v := range ds.index {
+		index[k] = v
+	}
+	return &DisjointSet[T]{
+		index:  index,
+		values: append([]T(nil), ds.values...),
+		parent: append([]int(nil), ds.parent...),
+		rank:   append([]uint8(nil), ds.rank...),
+		size:   append([]uint(nil), ds.size...),
+		next:   append([]int(nil), ds.next...),
+		count:  ds.count,
+	}
+}
@@ -0,0 +1,135 @@
+package disjointset
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// end - номер элемента, соответствующий концу последовательности.
+const end = -1
+
+// membersIterator представляет собой прямой итератор по кольцевому списку элементов одного множества.
+// Объединение множеств во время обхода инвалидирует итератор.
+type membersIterator[T comparable] struct {
+	ds      *DisjointSet[T]
+	start   int
+	current int
+}
+
+// newMembersIterator создает итератор, начинающий обход множества с элемента start.
+func newMembersIterator[T comparable](ds *DisjointSet[T], start int) *membersIterator[T] {
+	return &membersIterator[T]{
+		ds:      ds,
+		start:   start,
+		current: start,
+	}
+}
+
+// HasNext проверяет, указывает ли итератор на элемент множества.
+func (it *membersIterator[T]) HasNext() bool {
+	return it.current != end
+}
+
+// Next переходит к следующему элементу множества.
+func (it *membersIterator[T]) Next() {
+	it.current = it.ds.next[it.current]
+	if it.current == it.start {
+		it.current = end
+	}
+}
+
+// Value возвращает текущее значение итератора.
+func (it *membersIterator[T]) Value() T {
+	return it.ds.values[it.current]
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+// Изменение значения через указатель нарушает целостность системы множеств.
+func (it *membersIterator[T]) Ptr() *T {
+	return &it.ds.values[it.current]
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *membersIterator[T]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *membersIterator[T]:
+		return it.current == a.current
+	case *iterators.EndIterator:
+		return it.current == end
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *membersIterator[T]) Copy() copiable.Copiable {
+	return &membersIterator[T]{
+		ds:      it.ds,
+		start:   it.start,
+		current: it.current,
+	}
+}
+
+// representativesIterator представляет собой прямой итератор по представителям множеств.
+type representativesIterator[T comparable] struct {
+	ds      *DisjointSet[T]
+	current int
+}
+
+// newRepresentativesIterator создает итератор, указывающий на первого представителя.
+func newRepresentativesIterator[T comparable](ds *DisjointSet[T]) *representativesIterator[T] {
+	it := &representativesIterator[T]{ds: ds, current: end}
+	it.seek(0)
+	return it
+}
+
+// seek перемещает итератор на первого представителя с номером не меньше from.
+func (it *representativesIterator[T]) seek(from int) {
+	for i := from; i < len(it.ds.parent); i++ {
+		if it.ds.parent[i] == i {
+			it.current = i
+			return
+		}
+	}
+	it.current = end
+}
+
+// HasNext проверяет, указывает ли итератор на представителя.
+func (it *representativesIterator[T]) HasNext() bool {
+	return it.current != end
+}
+
+// Next переходит к следующему представителю.
+func (it *representativesIterator[T]) Next() {
+	it.seek(it.current + 1)
+}
+
+// Value возвращает текущее значение итератора.
+func (it *representativesIterator[T]) Value() T {
+	return it.ds.values[it.current]
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+// Изменение значения через указатель нарушает целостность системы множеств.
+func (it *representativesIterator[T]) Ptr() *T {
+	return &it.ds.values[it.current]
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *representativesIterator[T]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *representativesIterator[T]:
+		return it.current == a.current
+	case *iterators.EndIterator:
+		return it.current == end
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *representativesIterator[T]) Copy() copiable.Copiable {
+	return &representativesIterator[T]{
+		ds:      it.ds,
+		current: it.current,
+	}
+}
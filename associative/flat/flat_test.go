@@ -0,0 +1,111 @@
+package flat
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/pair"
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+// randomPairs возвращает n пар с ключами из [0, keys) и уникальными значениями,
+// по которым можно определить, какая из пар с одинаковым ключом сохранилась.
+func randomPairs(rnd *rand.Rand, n, keys, tag int) []pair.Pair[int, int] {
+	items := make([]pair.Pair[int, int], n)
+	for i := range items {
+		items[i] = pair.NewPair(rnd.Intn(keys), tag+i)
+	}
+	return items
+}
+
+// elements возвращает элементы упорядоченного вектора в порядке хранения.
+func elements[T any](s sorted[T]) []T {
+	result := make([]T, 0, s.v.Size())
+	for i := uint(0); i < s.v.Size(); i++ {
+		result = append(result, s.v.At(i))
+	}
+	return result
+}
+
+// byFirst сравнивает пары только по первому элементу.
+func byFirst(a, b pair.Pair[int, int]) int {
+	return comparator.DefaultComparator[int]()(a.First, b.First)
+}
+
+func TestFlatMapInsertRangeMatchesInsert(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		initial := randomPairs(rnd, rnd.Intn(30), 50, 0)
+		batch := randomPairs(rnd, rnd.Intn(30), 50, 1000)
+
+		expected := NewFlatMap[int, int](comparator.DefaultComparator[int]())
+		for _, p := range initial {
+			expected.Insert(p.First, p.Second)
+		}
+		actual := expected.Copy().(*FlatMap[int, int])
+
+		for _, p := range batch {
+			expected.Insert(p.First, p.Second)
+		}
+		v := vector.NewVector(batch...)
+		actual.InsertRange(v.Begin(), v.End())
+
+		require.Equal(t, expected.Size(), actual.Size())
+		require.Equal(t, elements(expected.s), elements(actual.s))
+	}
+}
+
+func TestFlatMapInsertRangeLastDuplicateWins(t *testing.T) {
+	m := NewFlatMap[int, string](comparator.DefaultComparator[int](), pair.NewPair(1, "old"))
+	v := vector.NewVector(
+		pair.NewPair(2, "first"),
+		pair.NewPair(1, "new"),
+		pair.NewPair(2, "last"),
+	)
+	m.InsertRange(v.Begin(), v.End())
+
+	value, _ := m.Get(1)
+	assert.Equal(t, "new", value)
+	value, _ = m.Get(2)
+	assert.Equal(t, "last", value)
+	assert.Equal(t, uint(2), m.Size())
+}
+
+func TestFlatSetInsertRangeMatchesInsert(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		initial := randomPairs(rnd, rnd.Intn(30), 50, 0)
+		batch := randomPairs(rnd, rnd.Intn(30), 50, 1000)
+
+		expected := NewFlatSet[pair.Pair[int, int]](byFirst)
+		for _, p := range initial {
+			expected.Insert(p)
+		}
+		actual := expected.Copy().(*FlatSet[pair.Pair[int, int]])
+
+		for _, p := range batch {
+			expected.Insert(p)
+		}
+		v := vector.NewVector(batch...)
+		actual.InsertRange(v.Begin(), v.End())
+
+		require.Equal(t, expected.Size(), actual.Size())
+		require.Equal(t, elements(expected.s), elements(actual.s))
+	}
+}
+
+func TestFlatSetInsertRangeFirstDuplicateWins(t *testing.T) {
+	s := NewFlatSet[pair.Pair[int, int]](byFirst, pair.NewPair(1, 0))
+	v := vector.NewVector(
+		pair.NewPair(2, 1),
+		pair.NewPair(1, 2),
+		pair.NewPair(2, 3),
+	)
+	s.InsertRange(v.Begin(), v.End())
+
+	assert.Equal(t, []pair.Pair[int, int]{pair.NewPair(1, 0), pair.NewPair(2, 1)}, elements(s.s))
+}
@@ -0,0 +1,140 @@
+package flat
+
+import (
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/pair"
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+// FlatMap представляет собой упорядоченное отображение, хранящее пары ключ-значение
+// в векторе, отсортированном по ключам.
+// Поиск выполняется за O(log n), вставка и удаление одного элемента - за O(n).
+// Итераторы становятся недействительными после любого изменения отображения.
+type FlatMap[K any, V any] struct {
+	s sorted[pair.Pair[K, V]]
+}
+
+// NewFlatMap создает новое отображение с заданным компаратором ключей и заполняет его переданными парами.
+// Если ключ встречается несколько раз, сохраняется последнее значение.
+func NewFlatMap[K any, V any](comp comparator.Comparator[K], items ...pair.Pair[K, V]) *FlatMap[K, V] {
+	m := &FlatMap[K, V]{
+		s: newSorted(func(a, b pair.Pair[K, V]) bool {
+			return comp(a.First, b.First) < 0
+		}),
+	}
+	batch := vector.NewVectorFromSlice(items)
+	m.InsertRange(batch.Begin(), batch.End())
+	return m
+}
+
+// probe возвращает служебную пару для поиска по ключу.
+func (m *FlatMap[K, V]) probe(key K) pair.Pair[K, V] {
+	return pair.Pair[K, V]{First: key}
+}
+
+// Size возвращает количество элементов в отображении.
+func (m *FlatMap[K, V]) Size() uint {
+	return m.s.v.Size()
+}
+
+// IsEmpty проверяет что отображение пустое.
+func (m *FlatMap[K, V]) IsEmpty() bool {
+	return m.s.v.IsEmpty()
+}
+
+// Clear удаляет все элементы из отображения.
+func (m *FlatMap[K, V]) Clear() {
+	m.s.v = vector.NewVector[pair.Pair[K, V]]()
+}
+
+// Get возвращает значение по ключу и признак его наличия.
+func (m *FlatMap[K, V]) Get(key K) (V, bool) {
+	i, found := m.s.find(m.probe(key))
+	if !found {
+		var zero V
+		return zero, false
+	}
+	return m.s.v.At(i).Second, true
+}
+
+// Contains проверяет есть ли ключ в отображении.
+func (m *FlatMap[K, V]) Contains(key K) bool {
+	_, found := m.s.find(m.probe(key))
+	return found
+}
+
+// Insert вставляет пару ключ-значение в отображение. Если ключ уже существует, его значение заменяется.
+func (m *FlatMap[K, V]) Insert(key K, value V) {
+	m.s.insert(pair.NewPair(key, value), true)
+}
+
+// InsertRange вставляет пары из диапазона [begin, end).
+// Пары сортируются один раз и сливаются с отображением за O(n + k*log k), где k - длина диапазона.
+// Результат совпадает с поочередной вставкой пар методом Insert:
+// значения существующих ключей заменяются, из повторяющихся в диапазоне ключей побеждает последний.
+func (m *FlatMap[K, V]) InsertRange(begin interfaces.ForwardIterator[pair.Pair[K, V]], end interfaces.Iterator) {
+	m.s.insertRange(begin, end, true)
+}
+
+// Delete удаляет элемент с ключом key.
+// Возвращает true в случае успешного удаления.
+func (m *FlatMap[K, V]) Delete(key K) bool {
+	return m.s.erase(m.probe(key))
+}
+
+// Find возвращает итератор на элемент с заданным ключом.
+// Если ключ не найден, возвращает конечный итератор.
+func (m *FlatMap[K, V]) Find(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	i, found := m.s.find(m.probe(key))
+	if !found {
+		return m.End()
+	}
+	return m.iteratorAt(i)
+}
+
+// LowerBound возвращает итератор на первый элемент, ключ которого не меньше key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (m *FlatMap[K, V]) LowerBound(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return m.iteratorAt(m.s.lowerBound(m.probe(key)))
+}
+
+// UpperBound возвращает итератор на первый элемент, ключ которого больше key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (m *FlatMap[K, V]) UpperBound(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return m.iteratorAt(m.s.upperBound(m.probe(key)))
+}
+
+// iteratorAt возвращает итератор на элемент с позицией index.
+func (m *FlatMap[K, V]) iteratorAt(index uint) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	it := m.s.v.Begin()
+	it.Shift(int(index))
+	return it
+}
+
+// Begin возвращает итератор с произвольным доступом на элемент с минимальным ключом.
+// Изменение ключа через указатель итератора нарушает упорядоченность отображения.
+func (m *FlatMap[K, V]) Begin() interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return m.s.v.Begin()
+}
+
+// End возвращает итератор на элемент после последнего.
+func (m *FlatMap[K, V]) End() interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return m.s.v.End()
+}
+
+// RBegin возвращает перевернутый итератор на элемент с максимальным ключом.
+func (m *FlatMap[K, V]) RBegin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return m.s.v.RBegin()
+}
+
+// REnd возвращает итератор на конец перевернутого отображения.
+func (m *FlatMap[K, V]) REnd() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return m.s.v.REnd()
+}
+
+// Copy возвращает копию отображения.
+func (m *FlatMap[K, V]) Copy() copiable.Copiable {
+	return &FlatMap[K, V]{s: m.s.clone()}
+}
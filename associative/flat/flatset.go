@@ -0,0 +1,122 @@
+package flat
+
+import (
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+// FlatSet представляет собой упорядоченное множество, хранящее элементы в отсортированном векторе.
+// Поиск выполняется за O(log n), вставка и удаление одного элемента - за O(n).
+// Итераторы становятся недействительными после любого изменения множества.
+type FlatSet[K any] struct {
+	s sorted[K]
+}
+
+// NewFlatSet создает новое множество с заданным компаратором и заполняет его переданными элементами.
+func NewFlatSet[K any](comp comparator.Comparator[K], items ...K) *FlatSet[K] {
+	s := &FlatSet[K]{
+		s: newSorted(func(a, b K) bool {
+			return comp(a, b) < 0
+		}),
+	}
+	batch := vector.NewVectorFromSlice(items)
+	s.InsertRange(batch.Begin(), batch.End())
+	return s
+}
+
+// Size возвращает количество элементов в множестве.
+func (s *FlatSet[K]) Size() uint {
+	return s.s.v.Size()
+}
+
+// IsEmpty проверяет что множество пустое.
+func (s *FlatSet[K]) IsEmpty() bool {
+	return s.s.v.IsEmpty()
+}
+
+// Clear удаляет все элементы из множества.
+func (s *FlatSet[K]) Clear() {
+	s.s.v = vector.NewVector[K]()
+}
+
+// Contains проверяет есть ли элемент в множестве.
+func (s *FlatSet[K]) Contains(k K) bool {
+	_, found := s.s.find(k)
+	return found
+}
+
+// Insert добавляет элемент в множество.
+// Возвращает false, если эквивалентный элемент уже есть в множестве.
+func (s *FlatSet[K]) Insert(k K) bool {
+	return s.s.insert(k, false)
+}
+
+// InsertRange добавляет элементы из диапазона [begin, end).
+// Элементы сортируются один раз и сливаются с множеством за O(n + k*log k), где k - длина диапазона.
+// Уже присутствующие в множестве элементы не заменяются.
+func (s *FlatSet[K]) InsertRange(begin interfaces.ForwardIterator[K], end interfaces.Iterator) {
+	s.s.insertRange(begin, end, false)
+}
+
+// Delete удаляет элемент из множества.
+// Возвращает true в случае успешного удаления.
+func (s *FlatSet[K]) Delete(k K) bool {
+	return s.s.erase(k)
+}
+
+// Find возвращает итератор на элемент, эквивалентный k.
+// Если элемент не найден, возвращает конечный итератор.
+func (s *FlatSet[K]) Find(k K) interfaces.RandomAccessIterator[K] {
+	i, found := s.s.find(k)
+	if !found {
+		return s.End()
+	}
+	return s.iteratorAt(i)
+}
+
+// LowerBound возвращает итератор на первый элемент, не меньший k.
+// Если такого элемента нет, возвращает конечный итератор.
+func (s *FlatSet[K]) LowerBound(k K) interfaces.RandomAccessIterator[K] {
+	return s.iteratorAt(s.s.lowerBound(k))
+}
+
+// UpperBound возвращает итератор на первый элемент, больший k.
+// Если такого элемента нет, возвращает конечный итератор.
+func (s *FlatSet[K]) UpperBound(k K) interfaces.RandomAccessIterator[K] {
+	return s.iteratorAt(s.s.upperBound(k))
+}
+
+// iteratorAt возвращает итератор на элемент с позицией index.
+func (s *FlatSet[K]) iteratorAt(index uint) interfaces.RandomAccessIterator[K] {
+	it := s.s.v.Begin()
+	it.Shift(int(index))
+	return it
+}
+
+// Begin возвращает итератор с произвольным доступом на минимальный элемент.
+// Изменение элемента через указатель итератора нарушает упорядоченность множества.
+func (s *FlatSet[K]) Begin() interfaces.RandomAccessIterator[K] {
+	return s.s.v.Begin()
+}
+
+// End возвращает итератор на элемент после последнего.
+func (s *FlatSet[K]) End() interfaces.RandomAccessIterator[K] {
+	return s.s.v.End()
+}
+
+// RBegin возвращает перевернутый итератор на максимальный элемент.
+func (s *FlatSet[K]) RBegin() interfaces.BidirectionalIterator[K] {
+	return s.s.v.RBegin()
+}
+
+// REnd возвращает итератор на конец перевернутого множества.
+func (s *FlatSet[K]) REnd() interfaces.BidirectionalIterator[K] {
+	return s.s.v.REnd()
+}
+
+// Copy возвращает копию множества.
+func (s *FlatSet[K]) Copy() copiable.Copiable {
+	return &FlatSet[K]{s: s.s.clone()}
+}
@@ -0,0 +1,128 @@
+// Package flat содержит упорядоченные контейнеры, хранящие элементы в отсортированном векторе.
+// Поиск выполняется бинарным поиском, а обход идет по непрерывной памяти, поэтому такие контейнеры
+// выгоднее деревьев для небольших наборов данных, которые чаще читаются, чем изменяются.
+package flat
+
+import (
+	"github.com/Delisa-sama/collections/algorithms"
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+// sorted представляет собой вектор, упорядоченный по возрастанию без эквивалентных элементов.
+type sorted[T any] struct {
+	v    *vector.Vector[T]
+	less comparator.Less[T]
+}
+
+// newSorted создает пустой упорядоченный вектор с заданной функцией сравнения.
+func newSorted[T any](less comparator.Less[T]) sorted[T] {
+	return sorted[T]{v: vector.NewVector[T](), less: less}
+}
+
+// lowerBound возвращает позицию первого элемента, не меньшего x.
+func (s *sorted[T]) lowerBound(x T) uint {
+	it := algorithms.LowerBoundC[T](s.v.Begin(), s.v.End(), x, s.less)
+	return it.(interfaces.RandomAccessIterator[T]).Index()
+}
+
+// upperBound возвращает позицию первого элемента, большего x.
+func (s *sorted[T]) upperBound(x T) uint {
+	it := algorithms.UpperBoundC[T](s.v.Begin(), s.v.End(), x, s.less)
+	return it.(interfaces.RandomAccessIterator[T]).Index()
+}
+
+// find возвращает позицию элемента, эквивалентного x, и признак его наличия.
+func (s *sorted[T]) find(x T) (uint, bool) {
+	i := s.lowerBound(x)
+	return i, i < s.v.Size() && !s.less(x, s.v.At(i))
+}
+
+// insert вставляет x на его место в порядке.
+// Если эквивалентный элемент уже есть, он заменяется при replace, иначе остается на месте.
+// Возвращает true, если добавлен новый элемент.
+func (s *sorted[T]) insert(x T, replace bool) bool {
+	i, found := s.find(x)
+	if !found {
+		s.v.Insert(i, x)
+		return true
+	}
+	if replace {
+		p, _ := s.v.Begin().At(i)
+		*p = x
+	}
+	return false
+}
+
+// ranked - элемент вставляемого диапазона вместе с его позицией в диапазоне.
+type ranked[T any] struct {
+	value T
+	pos   uint
+}
+
+// insertRange вставляет элементы диапазона [begin, end): сортирует их один раз и сливает с вектором.
+// Результат совпадает с поочередной вставкой элементов методом insert.
+func (s *sorted[T]) insertRange(begin interfaces.ForwardIterator[T], end interfaces.Iterator, replace bool) {
+	batch := vector.NewVector[ranked[T]]()
+	for it := begin; !it.Equals(end); it.Next() {
+		batch.PushBack(ranked[T]{value: it.Value(), pos: batch.Size()})
+	}
+	if batch.IsEmpty() {
+		return
+	}
+
+	// Позиция в диапазоне упорядочивает эквивалентные элементы, делая сортировку устойчивой.
+	algorithms.SortC(batch.Begin(), batch.End(), func(a, b ranked[T]) bool {
+		if s.less(a.value, b.value) {
+			return true
+		}
+		if s.less(b.value, a.value) {
+			return false
+		}
+		return a.pos < b.pos
+	})
+
+	merged := make([]T, 0, s.v.Size()+batch.Size())
+	var i, j uint
+	for j < batch.Size() {
+		x := batch.At(j).value
+		// Из группы эквивалентных элементов диапазона остается последний при replace, иначе первый.
+		for j++; j < batch.Size() && !s.less(x, batch.At(j).value); j++ {
+			if replace {
+				x = batch.At(j).value
+			}
+		}
+
+		for i < s.v.Size() && s.less(s.v.At(i), x) {
+			merged = append(merged, s.v.At(i))
+			i++
+		}
+		if i < s.v.Size() && !s.less(x, s.v.At(i)) {
+			if !replace {
+				x = s.v.At(i)
+			}
+			i++
+		}
+		merged = append(merged, x)
+	}
+	for ; i < s.v.Size(); i++ {
+		merged = append(merged, s.v.At(i))
+	}
+	s.v = vector.NewVectorFromSlice(merged)
+}
+
+// erase удаляет элемент, эквивалентный x. Возвращает true в случае успешного удаления.
+func (s *sorted[T]) erase(x T) bool {
+	i, found := s.find(x)
+	if !found {
+		return false
+	}
+	s.v.Remove(i)
+	return true
+}
+
+// clone возвращает копию упорядоченного вектора.
+func (s *sorted[T]) clone() sorted[T] {
+	return sorted[T]{v: s.v.Copy().(*vector.Vector[T]), less: s.less}
+}
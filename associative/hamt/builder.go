@@ -0,0 +1,65 @@
+package hamt
+
+import "github.com/Delisa-sama/collections/pair"
+
+// Builder представляет собой изменяемый построитель новой версии отображения.
+// Узлы, созданные построителем, изменяются на месте, а узлы исходной версии копируются при первом изменении.
+type Builder[K any, V any] struct {
+	root  *node[K, V]
+	size  uint
+	ops   *ops[K, V]
+	owner *owner
+}
+
+// Size возвращает количество элементов в построителе.
+func (b *Builder[K, V]) Size() uint {
+	return b.size
+}
+
+// IsEmpty проверяет что построитель не содержит элементов.
+func (b *Builder[K, V]) IsEmpty() bool {
+	return b.size == 0
+}
+
+// Get возвращает значение по ключу и признак его наличия.
+func (b *Builder[K, V]) Get(key K) (V, bool) {
+	e := b.ops.find(b.root, b.ops.hasher(key), key)
+	if e == nil {
+		var zero V
+		return zero, false
+	}
+	return e.Second, true
+}
+
+// Contains проверяет есть ли ключ в построителе.
+func (b *Builder[K, V]) Contains(key K) bool {
+	return b.ops.find(b.root, b.ops.hasher(key), key) != nil
+}
+
+// Put добавляет пару ключ-значение. Если ключ уже существует, его значение заменяется.
+func (b *Builder[K, V]) Put(key K, value V) {
+	var added bool
+	b.root, added = b.ops.put(b.root, 0, b.ops.hasher(key), pair.NewPair(key, value), b.owner)
+	if added {
+		b.size++
+	}
+}
+
+// Delete удаляет ключ.
+// Возвращает true в случае успешного удаления.
+func (b *Builder[K, V]) Delete(key K) bool {
+	var deleted bool
+	b.root, deleted = b.ops.delete(b.root, 0, b.ops.hasher(key), key, b.owner)
+	if deleted {
+		b.size--
+	}
+	return deleted
+}
+
+// Persistent возвращает неизменяемую версию отображения с текущим содержимым построителя.
+// Построитель можно продолжать использовать: последующие изменения не затрагивают возвращенную версию.
+func (b *Builder[K, V]) Persistent() *HAMT[K, V] {
+	// Новая метка владельца запрещает изменять на месте узлы, ставшие частью неизменяемой версии.
+	b.owner = &owner{}
+	return &HAMT[K, V]{root: b.root, size: b.size, ops: b.ops}
+}
@@ -0,0 +1,108 @@
+package hamt
+
+import (
+	"github.com/Delisa-sama/collections/associative/hashmap"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// HAMT представляет собой неизменяемое (персистентное) хеш-отображение на основе hash array mapped trie.
+// Операции изменения возвращают новую версию отображения, разделяющую неизмененные узлы с исходной,
+// поэтому старые версии остаются доступными и не меняются.
+type HAMT[K any, V any] struct {
+	root *node[K, V]
+	size uint
+	ops  *ops[K, V]
+}
+
+// NewHAMT создает новое отображение с заданными функциями хеширования и равенства ключей
+// и заполняет его переданными парами.
+func NewHAMT[K any, V any](hasher hashmap.Hasher[K], equal hashmap.Equality[K], items ...pair.Pair[K, V]) *HAMT[K, V] {
+	m := &HAMT[K, V]{
+		root: &node[K, V]{},
+		ops:  &ops[K, V]{hasher: hasher, equal: equal},
+	}
+	if len(items) == 0 {
+		return m
+	}
+	b := m.Transient()
+	for i := range items {
+		b.Put(items[i].First, items[i].Second)
+	}
+	return b.Persistent()
+}
+
+// Size возвращает количество элементов в отображении.
+func (m *HAMT[K, V]) Size() uint {
+	return m.size
+}
+
+// IsEmpty проверяет что отображение пустое.
+func (m *HAMT[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Get возвращает значение по ключу и признак его наличия.
+func (m *HAMT[K, V]) Get(key K) (V, bool) {
+	e := m.ops.find(m.root, m.ops.hasher(key), key)
+	if e == nil {
+		var zero V
+		return zero, false
+	}
+	return e.Second, true
+}
+
+// Contains проверяет есть ли ключ в отображении.
+func (m *HAMT[K, V]) Contains(key K) bool {
+	return m.ops.find(m.root, m.ops.hasher(key), key) != nil
+}
+
+// With возвращает новую версию отображения, в которой ключу key соответствует значение value.
+// Исходная версия не изменяется.
+func (m *HAMT[K, V]) With(key K, value V) *HAMT[K, V] {
+	root, added := m.ops.put(m.root, 0, m.ops.hasher(key), pair.NewPair(key, value), nil)
+	size := m.size
+	if added {
+		size++
+	}
+	return &HAMT[K, V]{root: root, size: size, ops: m.ops}
+}
+
+// Without возвращает новую версию отображения без ключа key.
+// Если ключа нет, возвращает исходную версию. Исходная версия не изменяется.
+func (m *HAMT[K, V]) Without(key K) *HAMT[K, V] {
+	root, deleted := m.ops.delete(m.root, 0, m.ops.hasher(key), key, nil)
+	if !deleted {
+		return m
+	}
+	return &HAMT[K, V]{root: root, size: m.size - 1, ops: m.ops}
+}
+
+// Transient возвращает изменяемый построитель, инициализированный содержимым отображения.
+// Построитель изменяет собственные узлы на месте, что ускоряет серию изменений.
+func (m *HAMT[K, V]) Transient() *Builder[K, V] {
+	return &Builder[K, V]{
+		root:  m.root,
+		size:  m.size,
+		ops:   m.ops,
+		owner: &owner{},
+	}
+}
+
+// Begin возвращает итератор на первый элемент отображения.
+// Порядок перебора элементов не определен, но одинаков для одной и той же версии.
+func (m *HAMT[K, V]) Begin() interfaces.ForwardIterator[pair.Pair[K, V]] {
+	return newIterator(m.root)
+}
+
+// End возвращает итератор на элемент после последнего.
+func (m *HAMT[K, V]) End() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию отображения за O(1). Копия разделяет все узлы с исходной версией.
+func (m *HAMT[K, V]) Copy() copiable.Copiable {
+	return &HAMT[K, V]{root: m.root, size: m.size, ops: m.ops}
+}
@@ -0,0 +1,167 @@
+package hamt
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Delisa-sama/collections/associative/hashmap"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// hashers содержит функции хеширования, порождающие разные виды коллизий.
+var hashers = map[string]hashmap.Hasher[int]{
+	// Полные коллизии: много ключей в одной корзине.
+	"buckets": func(key int) uint64 { return uint64(key % 7) },
+	// Хеши различаются только старшими битами, поэтому узлы сливаются на глубину всего дерева.
+	"deep": func(key int) uint64 { return uint64(key%16) << 60 },
+	// Смесь корзин и глубоких веток.
+	"mixed": func(key int) uint64 { return uint64(key%5)<<59 | uint64(key%3) },
+}
+
+// checkModel сравнивает содержимое отображения с отображением-моделью.
+func checkModel(t *testing.T, m *HAMT[int, int], model map[int]int) {
+	t.Helper()
+	require.Equal(t, uint(len(model)), m.Size())
+	seen := make(map[int]int, len(model))
+	for it := m.Begin(); !it.Equals(m.End()); it.Next() {
+		_, duplicate := seen[it.Value().First]
+		require.False(t, duplicate, "key %d", it.Value().First)
+		seen[it.Value().First] = it.Value().Second
+	}
+	require.Equal(t, model, seen)
+	for key := -5; key < 205; key++ {
+		value, ok := m.Get(key)
+		expected, expectedOk := model[key]
+		require.Equal(t, expectedOk, ok, "key %d", key)
+		require.Equal(t, expected, value, "key %d", key)
+	}
+}
+
+// copyModel возвращает копию отображения-модели.
+func copyModel(model map[int]int) map[int]int {
+	c := make(map[int]int, len(model))
+	for k, v := range model {
+		c[k] = v
+	}
+	return c
+}
+
+func TestPersistentOperationsWithCollisions(t *testing.T) {
+	for name, hasher := range hashers {
+		t.Run(name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(1))
+			m := NewHAMT[int, int](hasher, hashmap.DefaultEquality[int]())
+			model := make(map[int]int)
+
+			var versions []*HAMT[int, int]
+			var models []map[int]int
+			for step := 0; step < 3000; step++ {
+				key := rnd.Intn(200)
+				if rnd.Intn(2) == 0 {
+					value := rnd.Int()
+					m = m.With(key, value)
+					model[key] = value
+				} else {
+					m = m.Without(key)
+					delete(model, key)
+				}
+				if step%300 == 0 {
+					checkModel(t, m, model)
+					versions = append(versions, m)
+					models = append(models, copyModel(model))
+				}
+			}
+			checkModel(t, m, model)
+
+			// Старые версии не изменяются последующими операциями.
+			for i := range versions {
+				checkModel(t, versions[i], models[i])
+			}
+		})
+	}
+}
+
+func TestTransientWithCollisions(t *testing.T) {
+	for name, hasher := range hashers {
+		t.Run(name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(2))
+			base := NewHAMT[int, int](hasher, hashmap.DefaultEquality[int]())
+			for key := 0; key < 100; key++ {
+				base = base.With(key, key)
+			}
+			baseModel := make(map[int]int)
+			for key := 0; key < 100; key++ {
+				baseModel[key] = key
+			}
+
+			b := base.Transient()
+			model := copyModel(baseModel)
+			var versions []*HAMT[int, int]
+			var models []map[int]int
+			for step := 0; step < 3000; step++ {
+				key := rnd.Intn(200)
+				if rnd.Intn(2) == 0 {
+					value := rnd.Int()
+					b.Put(key, value)
+					model[key] = value
+				} else {
+					_, ok := model[key]
+					require.Equal(t, ok, b.Delete(key))
+					delete(model, key)
+				}
+				require.Equal(t, uint(len(model)), b.Size())
+				if step%300 == 0 {
+					// Построитель продолжает изменяться после фиксации версии.
+					versions = append(versions, b.Persistent())
+					models = append(models, copyModel(model))
+				}
+			}
+			checkModel(t, b.Persistent(), model)
+
+			checkModel(t, base, baseModel)
+			for i := range versions {
+				checkModel(t, versions[i], models[i])
+			}
+		})
+	}
+}
+
+func TestTransientDeleteAllWithCollisions(t *testing.T) {
+	for name, hasher := range hashers {
+		t.Run(name, func(t *testing.T) {
+			var items []pair.Pair[int, int]
+			model := make(map[int]int)
+			for key := 0; key < 200; key += 3 {
+				items = append(items, pair.NewPair(key, -key))
+				model[key] = -key
+			}
+			m := NewHAMT(hasher, hashmap.DefaultEquality[int](), items...)
+			checkModel(t, m, model)
+
+			c := m.Transient()
+			for key := range model {
+				require.True(t, c.Delete(key))
+			}
+			require.True(t, c.IsEmpty())
+			require.True(t, c.Persistent().Begin().Equals(c.Persistent().End()))
+			checkModel(t, m, model)
+		})
+	}
+}
+
+func TestIteratorNextOnEnd(t *testing.T) {
+	m := NewHAMT[int, int](hashers["buckets"], hashmap.DefaultEquality[int](), pair.NewPair(1, 1))
+
+	it := m.Begin()
+	it.Next()
+	require.True(t, it.Equals(m.End()))
+	it.Next()
+	require.True(t, it.Equals(m.End()))
+
+	empty := NewHAMT[int, int](hashers["buckets"], hashmap.DefaultEquality[int]())
+	it = empty.Begin()
+	it.Next()
+	require.True(t, it.Equals(empty.End()))
+}
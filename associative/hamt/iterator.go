@@ -0,0 +1,111 @@
+package hamt
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// frame представляет собой шаг пути от корня к текущей ячейке.
+type frame[K any, V any] struct {
+	n *node[K, V]
+	i int
+}
+
+// iterator представляет собой прямой итератор по элементам отображения.
+// Версии отображения неизменяемы, поэтому итератор остается действительным при создании новых версий.
+type iterator[K any, V any] struct {
+	path []frame[K, V] // Пустой путь соответствует концу отображения.
+	j    int           // Индекс текущего элемента в корзине ячейки.
+}
+
+// newIterator создает итератор, указывающий на первый элемент поддерева root.
+func newIterator[K any, V any](root *node[K, V]) *iterator[K, V] {
+	it := &iterator[K, V]{path: []frame[K, V]{{n: root}}}
+	it.settle()
+	return it
+}
+
+// isEnd проверяет, указывает ли итератор на конец отображения.
+func (it *iterator[K, V]) isEnd() bool {
+	return len(it.path) == 0
+}
+
+// slot возвращает текущую ячейку.
+func (it *iterator[K, V]) slot() *slot[K, V] {
+	top := it.path[len(it.path)-1]
+	return &top.n.slots[top.i]
+}
+
+// settle перемещает итератор на первую корзину, начиная с текущей позиции пути.
+func (it *iterator[K, V]) settle() {
+	for !it.isEnd() {
+		top := &it.path[len(it.path)-1]
+		if top.i == len(top.n.slots) {
+			it.path = it.path[:len(it.path)-1]
+			if !it.isEnd() {
+				it.path[len(it.path)-1].i++
+			}
+			continue
+		}
+		if child := top.n.slots[top.i].child; child != nil {
+			it.path = append(it.path, frame[K, V]{n: child})
+			continue
+		}
+		it.j = 0
+		return
+	}
+}
+
+// HasNext проверяет, указывает ли итератор на элемент отображения.
+func (it *iterator[K, V]) HasNext() bool {
+	return !it.isEnd()
+}
+
+// Next переходит к следующему элементу.
+// Для итератора, указывающего на конец отображения, ничего не делает.
+func (it *iterator[K, V]) Next() {
+	if it.isEnd() {
+		return
+	}
+	it.j++
+	if it.j < len(it.slot().bucket) {
+		return
+	}
+	it.path[len(it.path)-1].i++
+	it.settle()
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator[K, V]) Value() pair.Pair[K, V] {
+	return it.slot().bucket[it.j]
+}
+
+// Ptr возвращает указатель на копию текущего значения итератора.
+// Элементы разделяются версиями отображения, поэтому изменение через указатель не затрагивает отображение.
+func (it *iterator[K, V]) Ptr() *pair.Pair[K, V] {
+	value := it.Value()
+	return &value
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *iterator[K, V]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[K, V]:
+		if it.isEnd() || a.isEnd() {
+			return it.isEnd() && a.isEnd()
+		}
+		return it.slot() == a.slot() && it.j == a.j
+	case *iterators.EndIterator:
+		return it.isEnd()
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *iterator[K, V]) Copy() copiable.Copiable {
+	path := make([]frame[K, V], len(it.path))
+	copy(path, it.path)
+	return &iterator[K, V]{path: path, j: it.j}
+}
@@ -0,0 +1,218 @@
+package hamt
+
+import (
+	"math/bits"
+
+	"github.com/Delisa-sama/collections/associative/hashmap"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+const (
+	// bitsPerLevel - количество бит хеша, определяющих позицию на одном уровне дерева.
+	bitsPerLevel = 5
+	// levelMask - маска для выделения позиции на уровне.
+	levelMask = 1<<bitsPerLevel - 1
+)
+
+// owner - метка владельца узлов. Узлы с меткой изменяемого построителя можно изменять на месте.
+// Поле нужно для того, чтобы разные метки гарантированно имели разные адреса.
+type owner struct {
+	_ byte
+}
+
+// slot представляет собой ячейку узла: ссылку на дочерний узел
+// либо корзину элементов с одинаковым хешем.
+type slot[K any, V any] struct {
+	child  *node[K, V]
+	hash   uint64
+	bucket []pair.Pair[K, V]
+}
+
+// node представляет собой узел префиксного дерева с битовой картой занятых позиций.
+// Ячейки хранятся компактно, индекс ячейки равен количеству установленных бит карты перед позицией.
+type node[K any, V any] struct {
+	bitmap uint32
+	slots  []slot[K, V]
+	owner  *owner
+}
+
+// position возвращает бит позиции хеша на уровне shift и индекс соответствующей ячейки.
+func (n *node[K, V]) position(h uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((h >> shift) & levelMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// editable возвращает узел, который можно изменять от имени владельца o.
+// Узлы, принадлежащие другому владельцу или неизменяемым версиям, копируются.
+func (n *node[K, V]) editable(o *owner) *node[K, V] {
+	if o != nil && n.owner == o {
+		return n
+	}
+	slots := make([]slot[K, V], len(n.slots), len(n.slots)+1)
+	copy(slots, n.slots)
+	return &node[K, V]{bitmap: n.bitmap, slots: slots, owner: o}
+}
+
+// ops объединяет функции хеширования и сравнения ключей, необходимые для операций над узлами.
+type ops[K any, V any] struct {
+	hasher hashmap.Hasher[K]
+	equal  hashmap.Equality[K]
+}
+
+// find возвращает указатель на элемент с ключом key или nil.
+func (o *ops[K, V]) find(n *node[K, V], h uint64, key K) *pair.Pair[K, V] {
+	for shift := uint(0); ; shift += bitsPerLevel {
+		bit, idx := n.position(h, shift)
+		if n.bitmap&bit == 0 {
+			return nil
+		}
+		s := &n.slots[idx]
+		if s.child != nil {
+			n = s.child
+			continue
+		}
+		if s.hash != h {
+			return nil
+		}
+		for i := range s.bucket {
+			if o.equal(s.bucket[i].First, key) {
+				return &s.bucket[i]
+			}
+		}
+		return nil
+	}
+}
+
+// put вставляет пару в поддерево n от имени владельца own.
+// Возвращает новый корень поддерева и признак того, что ключ был добавлен, а не заменен.
+func (o *ops[K, V]) put(
+	n *node[K, V], shift uint, h uint64, entry pair.Pair[K, V], own *owner,
+) (*node[K, V], bool) {
+	bit, idx := n.position(h, shift)
+	if n.bitmap&bit == 0 {
+		n = n.editable(own)
+		n.slots = insertAt(n.slots, idx, slot[K, V]{hash: h, bucket: []pair.Pair[K, V]{entry}})
+		n.bitmap |= bit
+		return n, true
+	}
+
+	s := n.slots[idx]
+	if s.child != nil {
+		child, added := o.put(s.child, shift+bitsPerLevel, h, entry, own)
+		if child != s.child {
+			n = n.editable(own)
+			n.slots[idx].child = child
+		}
+		return n, added
+	}
+
+	if s.hash == h {
+		// Корзины разделяются версиями, поэтому всегда копируются при изменении.
+		bucket := make([]pair.Pair[K, V], len(s.bucket), len(s.bucket)+1)
+		copy(bucket, s.bucket)
+		added := true
+		for i := range bucket {
+			if o.equal(bucket[i].First, entry.First) {
+				bucket[i] = entry
+				added = false
+				break
+			}
+		}
+		if added {
+			bucket = append(bucket, entry)
+		}
+		n = n.editable(own)
+		n.slots[idx].bucket = bucket
+		return n, added
+	}
+
+	// Хеши различаются: ячейка заменяется дочерним узлом с обоими элементами.
+	child := merge(shift+bitsPerLevel, s, slot[K, V]{hash: h, bucket: []pair.Pair[K, V]{entry}}, own)
+	n = n.editable(own)
+	n.slots[idx] = slot[K, V]{child: child}
+	return n, true
+}
+
+// merge создает узел уровня shift, содержащий две ячейки с различными хешами.
+func merge[K any, V any](shift uint, a, b slot[K, V], own *owner) *node[K, V] {
+	ia := uint32(a.hash>>shift) & levelMask
+	ib := uint32(b.hash>>shift) & levelMask
+	if ia == ib {
+		return &node[K, V]{
+			bitmap: 1 << ia,
+			slots:  []slot[K, V]{{child: merge(shift+bitsPerLevel, a, b, own)}},
+			owner:  own,
+		}
+	}
+	if ia > ib {
+		a, b = b, a
+	}
+	return &node[K, V]{
+		bitmap: 1<<ia | 1<<ib,
+		slots:  []slot[K, V]{a, b},
+		owner:  own,
+	}
+}
+
+// delete удаляет ключ из поддерева n от имени владельца own.
+// Возвращает новый корень поддерева и признак успешного удаления.
+func (o *ops[K, V]) delete(n *node[K, V], shift uint, h uint64, key K, own *owner) (*node[K, V], bool) {
+	bit, idx := n.position(h, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	s := n.slots[idx]
+	if s.child != nil {
+		child, deleted := o.delete(s.child, shift+bitsPerLevel, h, key, own)
+		if !deleted {
+			return n, false
+		}
+		n = n.editable(own)
+		if len(child.slots) == 1 && child.slots[0].child == nil {
+			// В дочернем узле осталась одна корзина: поднимаем её на текущий уровень.
+			n.slots[idx] = child.slots[0]
+		} else {
+			n.slots[idx].child = child
+		}
+		return n, true
+	}
+
+	if s.hash != h {
+		return n, false
+	}
+	for i := range s.bucket {
+		if !o.equal(s.bucket[i].First, key) {
+			continue
+		}
+		n = n.editable(own)
+		if len(s.bucket) == 1 {
+			n.slots = removeAt(n.slots, idx)
+			n.bitmap &^= bit
+			return n, true
+		}
+		bucket := make([]pair.Pair[K, V], 0, len(s.bucket)-1)
+		bucket = append(bucket, s.bucket[:i]...)
+		bucket = append(bucket, s.bucket[i+1:]...)
+		n.slots[idx].bucket = bucket
+		return n, true
+	}
+	return n, false
+}
+
+// insertAt вставляет значение в слайс в позицию i.
+func insertAt[T any](s []T, i int, v T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+// removeAt удаляет элемент слайса в позиции i, обнуляя освободившуюся ячейку.
+func removeAt[T any](s []T, i int) []T {
+	copy(s[i:], s[i+1:])
+	var zero T
+	s[len(s)-1] = zero
+	return s[:len(s)-1]
+}
@@ -0,0 +1,137 @@
+package hashmap
+
+import (
+	"hash/maphash"
+	"math"
+
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// Hasher - это функция, вычисляющая хеш ключа типа K.
+// Для равных ключей хеш обязан совпадать.
+type Hasher[K any] func(key K) uint64
+
+// Equality - это функция, проверяющая два ключа типа K на равенство.
+type Equality[K any] func(a, b K) bool
+
+// integer объединяет под собой все целочисленные типы.
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// float объединяет под собой все типы чисел с плавающей точкой.
+type float interface {
+	~float32 | ~float64
+}
+
+// seed используется строковыми хешерами, инициализируется случайно при старте процесса.
+var seed = maphash.MakeSeed()
+
+// mix перемешивает биты значения (финализатор splitmix64).
+//
+//nolint:mnd // константы алгоритма splitmix64
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// Combine объединяет два хеша в один. Результат зависит от порядка аргументов.
+//
+//nolint:mnd // константы алгоритма hash_combine
+func Combine(h1, h2 uint64) uint64 {
+	return mix(h1 ^ (h2 + 0x9e3779b97f4a7c15 + (h1 << 6) + (h1 >> 2)))
+}
+
+// DefaultEquality возвращает функцию равенства для сравнимых типов.
+func DefaultEquality[K comparable]() Equality[K] {
+	return func(a, b K) bool {
+		return a == b
+	}
+}
+
+// StringHasher возвращает хешер для строк.
+func StringHasher[K ~string]() Hasher[K] {
+	return func(key K) uint64 {
+		return maphash.String(seed, string(key))
+	}
+}
+
+// BytesHasher возвращает хешер для слайсов байт.
+func BytesHasher[K ~[]byte]() Hasher[K] {
+	return func(key K) uint64 {
+		return maphash.Bytes(seed, key)
+	}
+}
+
+// IntegerHasher возвращает хешер для целочисленных типов.
+func IntegerHasher[K integer]() Hasher[K] {
+	return func(key K) uint64 {
+		return mix(uint64(key))
+	}
+}
+
+// FloatHasher возвращает хешер для чисел с плавающей точкой.
+// Положительный и отрицательный нули имеют одинаковый хеш.
+func FloatHasher[K float]() Hasher[K] {
+	return func(key K) uint64 {
+		if key == 0 {
+			return mix(0)
+		}
+		return mix(math.Float64bits(float64(key)))
+	}
+}
+
+// BoolHasher возвращает хешер для логических значений.
+func BoolHasher[K ~bool]() Hasher[K] {
+	return func(key K) uint64 {
+		if key {
+			return mix(1)
+		}
+		return mix(0)
+	}
+}
+
+// SliceHasher возвращает хешер для слайсов, вычисляющий хеш по хешам элементов.
+func SliceHasher[T any](elem Hasher[T]) Hasher[[]T] {
+	return func(key []T) uint64 {
+		h := mix(uint64(len(key)))
+		for i := range key {
+			h = Combine(h, elem(key[i]))
+		}
+		return h
+	}
+}
+
+// SliceEquality возвращает функцию поэлементного сравнения слайсов.
+func SliceEquality[T any](elem Equality[T]) Equality[[]T] {
+	return func(a, b []T) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !elem(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// PairHasher возвращает хешер для пар, вычисляющий хеш по хешам обоих элементов.
+func PairHasher[T1 any, T2 any](first Hasher[T1], second Hasher[T2]) Hasher[pair.Pair[T1, T2]] {
+	return func(key pair.Pair[T1, T2]) uint64 {
+		return Combine(first(key.First), second(key.Second))
+	}
+}
+
+// PairEquality возвращает функцию поэлементного сравнения пар.
+func PairEquality[T1 any, T2 any](first Equality[T1], second Equality[T2]) Equality[pair.Pair[T1, T2]] {
+	return func(a, b pair.Pair[T1, T2]) bool {
+		return first(a.First, b.First) && second(a.Second, b.Second)
+	}
+}
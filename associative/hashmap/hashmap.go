@@ -0,0 +1,248 @@
+package hashmap
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+const (
+	// DefaultMaxLoadFactor - максимальный коэффициент заполнения таблицы по умолчанию.
+	DefaultMaxLoadFactor = 0.8
+
+	// minBucketCount - минимальное количество корзин в непустой таблице.
+	minBucketCount = 8
+
+	// growthFactor - во сколько раз увеличивается таблица при переполнении.
+	growthFactor = 2
+)
+
+// bucket представляет собой ячейку таблицы с открытой адресацией.
+type bucket[K any, V any] struct {
+	entry pair.Pair[K, V]
+	hash  uint64
+	// dist - расстояние от желаемой ячейки плюс один, 0 означает пустую ячейку.
+	dist uint
+}
+
+// HashMap представляет собой хеш-таблицу с открытой адресацией и вытеснением по схеме Robin Hood.
+// Ключи не обязаны быть сравнимыми: хеширование и сравнение ключей выполняются переданными функциями,
+// поэтому ключами могут быть слайсы и структуры со слайсами.
+type HashMap[K any, V any] struct {
+	buckets       []bucket[K, V]
+	size          uint
+	hasher        Hasher[K]
+	equal         Equality[K]
+	maxLoadFactor float64
+}
+
+// NewHashMap создает новую хеш-таблицу с заданными функциями хеширования и равенства ключей
+// и заполняет её переданными парами.
+func NewHashMap[K any, V any](hasher Hasher[K], equal Equality[K], items ...pair.Pair[K, V]) *HashMap[K, V] {
+	m := &HashMap[K, V]{
+		hasher:        hasher,
+		equal:         equal,
+		maxLoadFactor: DefaultMaxLoadFactor,
+	}
+	m.Reserve(uint(len(items)))
+	for i := range items {
+		m.Put(items[i].First, items[i].Second)
+	}
+	return m
+}
+
+// Size возвращает количество элементов в хеш-таблице.
+func (m *HashMap[K, V]) Size() uint {
+	return m.size
+}
+
+// IsEmpty проверяет что хеш-таблица пустая.
+func (m *HashMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// BucketCount возвращает количество ячеек в таблице.
+func (m *HashMap[K, V]) BucketCount() uint {
+	return uint(len(m.buckets))
+}
+
+// LoadFactor возвращает текущий коэффициент заполнения таблицы.
+func (m *HashMap[K, V]) LoadFactor() float64 {
+	if len(m.buckets) == 0 {
+		return 0
+	}
+	return float64(m.size) / float64(len(m.buckets))
+}
+
+// MaxLoadFactor возвращает максимальный коэффициент заполнения, при превышении которого таблица расширяется.
+func (m *HashMap[K, V]) MaxLoadFactor() float64 {
+	return m.maxLoadFactor
+}
+
+// SetMaxLoadFactor устанавливает максимальный коэффициент заполнения таблицы.
+// Значение должно лежать в интервале (0, 1), иначе возникает паника.
+// Если текущее заполнение превышает новое значение, таблица расширяется.
+func (m *HashMap[K, V]) SetMaxLoadFactor(f float64) {
+	if f <= 0 || f >= 1 {
+		panic("max load factor must be in range (0, 1)")
+	}
+	m.maxLoadFactor = f
+	m.Reserve(m.size)
+}
+
+// Reserve подготавливает таблицу к хранению n элементов без перехеширования.
+func (m *HashMap[K, V]) Reserve(n uint) {
+	if n == 0 || float64(n) <= float64(len(m.buckets))*m.maxLoadFactor {
+		return
+	}
+	count := uint(minBucketCount)
+	for float64(n) > float64(count)*m.maxLoadFactor {
+		count *= growthFactor
+	}
+	m.rehash(count)
+}
+
+// rehash перестраивает таблицу с заданным количеством ячеек.
+func (m *HashMap[K, V]) rehash(count uint) {
+	old := m.buckets
+	m.buckets = make([]bucket[K, V], count)
+	for i := range old {
+		if old[i].dist != 0 {
+			m.insert(old[i].entry, old[i].hash)
+		}
+	}
+}
+
+// mask возвращает маску для вычисления индекса ячейки. Количество ячеек всегда является степенью двойки.
+func (m *HashMap[K, V]) mask() uint {
+	return uint(len(m.buckets)) - 1
+}
+
+// find возвращает индекс ячейки с ключом key и признак его наличия.
+func (m *HashMap[K, V]) find(key K) (uint, bool) {
+	if m.size == 0 {
+		return 0, false
+	}
+	h := m.hasher(key)
+	idx := uint(h) & m.mask()
+	for dist := uint(1); ; dist++ {
+		b := &m.buckets[idx]
+		// Ключ не может находиться дальше от своей ячейки, чем элемент, который его вытеснил бы.
+		if b.dist < dist {
+			return 0, false
+		}
+		if b.hash == h && m.equal(b.entry.First, key) {
+			return idx, true
+		}
+		idx = (idx + 1) & m.mask()
+	}
+}
+
+// insert вставляет новый элемент, про который известно что его ключа нет в таблице.
+// Элемент, находящийся ближе к своей желаемой ячейке, уступает место более "бедному".
+func (m *HashMap[K, V]) insert(entry pair.Pair[K, V], h uint64) {
+	cur := bucket[K, V]{entry: entry, hash: h, dist: 1}
+	idx := uint(h) & m.mask()
+	for {
+		b := &m.buckets[idx]
+		if b.dist == 0 {
+			*b = cur
+			return
+		}
+		if b.dist < cur.dist {
+			*b, cur = cur, *b
+		}
+		idx = (idx + 1) & m.mask()
+		cur.dist++
+	}
+}
+
+// Get возвращает значение по ключу и признак его наличия.
+func (m *HashMap[K, V]) Get(key K) (V, bool) {
+	idx, found := m.find(key)
+	if !found {
+		var zero V
+		return zero, false
+	}
+	return m.buckets[idx].entry.Second, true
+}
+
+// Contains проверяет есть ли ключ в хеш-таблице.
+func (m *HashMap[K, V]) Contains(key K) bool {
+	_, found := m.find(key)
+	return found
+}
+
+// Put добавляет пару ключ-значение в хеш-таблицу. Если ключ уже существует, его значение заменяется.
+// Вставка нового ключа инвалидирует все итераторы.
+func (m *HashMap[K, V]) Put(key K, value V) {
+	if idx, found := m.find(key); found {
+		m.buckets[idx].entry.Second = value
+		return
+	}
+	m.Reserve(m.size + 1)
+	m.insert(pair.NewPair(key, value), m.hasher(key))
+	m.size++
+}
+
+// Delete удаляет ключ из хеш-таблицы.
+// Возвращает true в случае успешного удаления.
+func (m *HashMap[K, V]) Delete(key K) bool {
+	idx, found := m.find(key)
+	if !found {
+		return false
+	}
+	// Сдвигаем последующие элементы цепочки на одну ячейку назад вместо пометки ячейки удаленной.
+	next := (idx + 1) & m.mask()
+	for m.buckets[next].dist > 1 {
+		m.buckets[idx] = m.buckets[next]
+		m.buckets[idx].dist--
+		idx = next
+		next = (next + 1) & m.mask()
+	}
+	m.buckets[idx] = bucket[K, V]{}
+	m.size--
+	return true
+}
+
+// Clear удаляет все элементы из хеш-таблицы, сохраняя выделенную память.
+func (m *HashMap[K, V]) Clear() {
+	clear(m.buckets)
+	m.size = 0
+}
+
+// Begin возвращает итератор на первый элемент хеш-таблицы.
+// Порядок перебора элементов не определен.
+func (m *HashMap[K, V]) Begin() interfaces.ForwardIterator[pair.Pair[K, V]] {
+	return newIterator(m, m.nextOccupied(0))
+}
+
+// End возвращает итератор на элемент после последнего.
+func (m *HashMap[K, V]) End() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// nextOccupied возвращает индекс первой занятой ячейки, начиная с from.
+// Если таких ячеек нет, возвращает количество ячеек.
+func (m *HashMap[K, V]) nextOccupied(from uint) uint {
+	for ; from < uint(len(m.buckets)); from++ {
+		if m.buckets[from].dist != 0 {
+			return from
+		}
+	}
+	return from
+}
+
+// Copy возвращает копию хеш-таблицы.
+func (m *HashMap[K, V]) Copy() copiable.Copiable {
+	bucketsCopy := make([]bucket[K, V], len(m.buckets))
+	copy(bucketsCopy, m.buckets)
+	return &HashMap[K, V]{
+		buckets:       bucketsCopy,
+		size:          m.size,
+		hasher:        m.hasher,
+		equal:         m.equal,
+		maxLoadFactor: m.maxLoadFactor,
+	}
+}
@@ -0,0 +1,172 @@
+package hashmap
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// collidingHasher сводит ключи к нескольким хешам, чтобы цепочки пробирования были длинными
+// и пересекались между собой.
+func collidingHasher(key int) uint64 {
+	return uint64(key % 13)
+}
+
+// checkInvariants проверяет расстояния ячеек от желаемых позиций, отсутствие разрывов в цепочках
+// и то, что коэффициент заполнения не превышает максимальный.
+func checkInvariants(t *testing.T, m *HashMap[int, int]) {
+	t.Helper()
+	n := uint(len(m.buckets))
+	if n == 0 {
+		require.Zero(t, m.size)
+		return
+	}
+	require.Zero(t, n&(n-1), "bucket count must be a power of two")
+	require.LessOrEqual(t, m.LoadFactor(), m.MaxLoadFactor())
+
+	var count uint
+	for i := range m.buckets {
+		b := &m.buckets[i]
+		if b.dist == 0 {
+			continue
+		}
+		count++
+		require.Equal(t, m.hasher(b.entry.First), b.hash)
+		desired := uint(b.hash) & m.mask()
+		require.Equal(t, (uint(i)-desired)&m.mask()+1, b.dist, "bucket %d", i)
+		// Элемент на расстоянии больше единицы должен следовать за занятой ячейкой,
+		// иначе поиск остановится раньше, чем дойдет до него.
+		prev := &m.buckets[(uint(i)-1)&m.mask()]
+		if b.dist > 1 {
+			require.GreaterOrEqual(t, prev.dist+1, b.dist, "bucket %d", i)
+		}
+	}
+	require.Equal(t, m.size, count)
+}
+
+// checkModel сравнивает содержимое таблицы с отображением-моделью.
+func checkModel(t *testing.T, m *HashMap[int, int], model map[int]int) {
+	t.Helper()
+	require.Equal(t, uint(len(model)), m.Size())
+	seen := make(map[int]int, len(model))
+	for it := m.Begin(); !it.Equals(m.End()); it.Next() {
+		_, dup := seen[it.Value().First]
+		require.False(t, dup)
+		seen[it.Value().First] = it.Value().Second
+	}
+	require.Equal(t, model, seen)
+}
+
+func TestRandomOperationsWithCollidingHasher(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	m := NewHashMap[int, int](collidingHasher, DefaultEquality[int]())
+	model := make(map[int]int)
+
+	for step := 0; step < 20000; step++ {
+		key := rnd.Intn(400)
+		switch op := rnd.Intn(100); {
+		case op < 50:
+			value := rnd.Int()
+			m.Put(key, value)
+			model[key] = value
+		case op < 90:
+			_, ok := model[key]
+			require.Equal(t, ok, m.Delete(key))
+			delete(model, key)
+		case op < 95:
+			m.SetMaxLoadFactor(0.3 + rnd.Float64()*0.65)
+		default:
+			m.Reserve(uint(rnd.Intn(600)))
+		}
+
+		value, ok := m.Get(key)
+		expected, expectedOk := model[key]
+		require.Equal(t, expectedOk, ok)
+		require.Equal(t, expected, value)
+		require.Equal(t, expectedOk, m.Contains(key))
+
+		if step%20 == 0 {
+			checkInvariants(t, m)
+			checkModel(t, m, model)
+		}
+	}
+	checkInvariants(t, m)
+	checkModel(t, m, model)
+}
+
+func TestReserveAvoidsRehash(t *testing.T) {
+	m := NewHashMap[int, int](IntegerHasher[int](), DefaultEquality[int]())
+	m.Reserve(100)
+	count := m.BucketCount()
+	assert.GreaterOrEqual(t, float64(count)*m.MaxLoadFactor(), 100.0)
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+	}
+	assert.Equal(t, count, m.BucketCount())
+	checkInvariants(t, m)
+}
+
+func TestSetMaxLoadFactorGrowsTable(t *testing.T) {
+	m := NewHashMap[int, int](collidingHasher, DefaultEquality[int]())
+	for i := 0; i < 50; i++ {
+		m.Put(i, i)
+	}
+	before := m.BucketCount()
+	m.SetMaxLoadFactor(0.25)
+	assert.Greater(t, m.BucketCount(), before)
+	checkInvariants(t, m)
+
+	assert.Panics(t, func() { m.SetMaxLoadFactor(0) })
+	assert.Panics(t, func() { m.SetMaxLoadFactor(1) })
+}
+
+func TestSliceKeys(t *testing.T) {
+	hasher := SliceHasher(IntegerHasher[int]())
+	equal := SliceEquality(DefaultEquality[int]())
+
+	assert.Equal(t, hasher([]int{1, 2, 3}), hasher([]int{1, 2, 3}))
+	assert.NotEqual(t, hasher([]int{1, 2, 3}), hasher([]int{3, 2, 1}))
+	assert.NotEqual(t, hasher(nil), hasher([]int{0}))
+	assert.Equal(t, hasher(nil), hasher([]int{}))
+	assert.True(t, equal([]int{1, 2, 3}, []int{1, 2, 3}))
+	assert.True(t, equal(nil, []int{}))
+	assert.False(t, equal([]int{1, 2}, []int{1, 2, 3}))
+	assert.False(t, equal([]int{1, 2, 4}, []int{1, 2, 3}))
+
+	m := NewHashMap[[]int, string](hasher, equal)
+	m.Put([]int{1, 2}, "a")
+	m.Put([]int{2, 1}, "b")
+	m.Put([]int{1, 2}, "c")
+	assert.Equal(t, uint(2), m.Size())
+	value, ok := m.Get([]int{1, 2})
+	assert.True(t, ok)
+	assert.Equal(t, "c", value)
+	assert.False(t, m.Contains([]int{1}))
+}
+
+func TestPairKeys(t *testing.T) {
+	hasher := PairHasher(StringHasher[string](), IntegerHasher[int]())
+	equal := PairEquality(DefaultEquality[string](), DefaultEquality[int]())
+
+	assert.Equal(t, hasher(pair.NewPair("a", 1)), hasher(pair.NewPair("a", 1)))
+	assert.NotEqual(t, hasher(pair.NewPair("a", 1)), hasher(pair.NewPair("a", 2)))
+	assert.NotEqual(t, hasher(pair.NewPair("a", 1)), hasher(pair.NewPair("b", 1)))
+	assert.True(t, equal(pair.NewPair("a", 1), pair.NewPair("a", 1)))
+	assert.False(t, equal(pair.NewPair("a", 1), pair.NewPair("a", 2)))
+	assert.False(t, equal(pair.NewPair("a", 1), pair.NewPair("b", 1)))
+
+	m := NewHashMap[pair.Pair[string, int], int](hasher, equal)
+	m.Put(pair.NewPair("a", 1), 1)
+	m.Put(pair.NewPair("a", 2), 2)
+	m.Put(pair.NewPair("a", 1), 3)
+	assert.Equal(t, uint(2), m.Size())
+	value, ok := m.Get(pair.NewPair("a", 1))
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+	assert.True(t, m.Delete(pair.NewPair("a", 2)))
+	assert.False(t, m.Contains(pair.NewPair("a", 2)))
+}
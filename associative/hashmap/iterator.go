@@ -0,0 +1,66 @@
+package hashmap
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// iterator представляет собой прямой итератор по занятым ячейкам хеш-таблицы.
+type iterator[K any, V any] struct {
+	m       *HashMap[K, V]
+	current uint
+}
+
+// newIterator создает новый итератор, указывающий на ячейку с индексом index.
+func newIterator[K any, V any](m *HashMap[K, V], index uint) *iterator[K, V] {
+	return &iterator[K, V]{
+		m:       m,
+		current: index,
+	}
+}
+
+// HasNext проверяет, есть ли следующий элемент.
+func (it *iterator[K, V]) HasNext() bool {
+	return !it.isEnd() && it.m.nextOccupied(it.current+1) < uint(len(it.m.buckets))
+}
+
+// Next переходит к следующему элементу.
+func (it *iterator[K, V]) Next() {
+	it.current = it.m.nextOccupied(it.current + 1)
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator[K, V]) Value() pair.Pair[K, V] {
+	return it.m.buckets[it.current].entry
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+// Изменение ключа через указатель нарушает целостность хеш-таблицы.
+func (it *iterator[K, V]) Ptr() *pair.Pair[K, V] {
+	return &it.m.buckets[it.current].entry
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *iterator[K, V]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[K, V]:
+		if it.isEnd() || a.isEnd() {
+			return it.isEnd() && a.isEnd()
+		}
+		return it.m == a.m && it.current == a.current
+	case *iterators.EndIterator:
+		return it.isEnd()
+	}
+	panic("unknown iterator type")
+}
+
+func (it *iterator[K, V]) isEnd() bool {
+	return it.current >= uint(len(it.m.buckets))
+}
+
+// Copy копирует итератор.
+func (it *iterator[K, V]) Copy() copiable.Copiable {
+	return newIterator(it.m, it.current)
+}
@@ -0,0 +1,46 @@
+package intervaltree
+
+import "github.com/Delisa-sama/collections/comparator"
+
+// Interval представляет собой полуоткрытый интервал [Low, High).
+type Interval[T any] struct {
+	Low  T
+	High T
+}
+
+// NewInterval создает новый интервал [low, high).
+func NewInterval[T any](low, high T) Interval[T] {
+	return Interval[T]{Low: low, High: high}
+}
+
+// query описывает условие поиска интервалов.
+// Непустой интервал [l, h) удовлетворяет запросу, если low < h и l < high (l <= high при closed).
+// Пустые интервалы не содержат точек, поэтому не удовлетворяют ни одному запросу, кроме запроса без ограничений.
+type query[T any] struct {
+	all    bool // Запрос без ограничений, удовлетворяет любой интервал.
+	low    T
+	high   T
+	closed bool
+}
+
+// matches проверяет, что интервал удовлетворяет запросу.
+func (q *query[T]) matches(comp comparator.Comparator[T], iv Interval[T]) bool {
+	if q.all {
+		return true
+	}
+	return comp(iv.Low, iv.High) < 0 && comp(q.low, iv.High) < 0 && !q.beyond(comp, iv.Low)
+}
+
+// beyond проверяет, что интервалы с левой границей low и все интервалы правее них не удовлетворяют запросу.
+func (q *query[T]) beyond(comp comparator.Comparator[T], low T) bool {
+	if q.all {
+		return false
+	}
+	c := comp(low, q.high)
+	return c > 0 || (c == 0 && !q.closed)
+}
+
+// prunes проверяет, что поддерево с максимальной правой границей maxHigh не содержит подходящих интервалов.
+func (q *query[T]) prunes(comp comparator.Comparator[T], maxHigh T) bool {
+	return !q.all && comp(maxHigh, q.low) <= 0
+}
@@ -0,0 +1,67 @@
+package intervaltree
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// iterator представляет собой прямой итератор по интервалам дерева, удовлетворяющим запросу.
+type iterator[T any, V any] struct {
+	tree    *IntervalTree[T, V]
+	q       *query[T]
+	current *node[T, V]
+}
+
+// newIterator создает итератор, указывающий на первый интервал дерева, удовлетворяющий запросу q.
+func newIterator[T any, V any](tree *IntervalTree[T, V], q *query[T]) *iterator[T, V] {
+	return &iterator[T, V]{
+		tree:    tree,
+		q:       q,
+		current: tree.firstMatch(tree.root, q),
+	}
+}
+
+// HasNext проверяет, есть ли ещё элементы для обхода.
+func (it *iterator[T, V]) HasNext() bool {
+	return it.current != nil
+}
+
+// Next переходит к следующему интервалу, удовлетворяющему запросу.
+func (it *iterator[T, V]) Next() {
+	if it.current != nil {
+		it.current = it.tree.nextMatch(it.current, it.q)
+	}
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator[T, V]) Value() pair.Pair[Interval[T], V] {
+	return it.current.Entry
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+// Изменение интервала через указатель нарушает целостность дерева.
+func (it *iterator[T, V]) Ptr() *pair.Pair[Interval[T], V] {
+	return &it.current.Entry
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *iterator[T, V]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[T, V]:
+		return it.current == a.current
+	case *iterators.EndIterator:
+		return it.current == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *iterator[T, V]) Copy() copiable.Copiable {
+	return &iterator[T, V]{
+		tree:    it.tree,
+		q:       it.q,
+		current: it.current,
+	}
+}
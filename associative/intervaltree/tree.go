@@ -0,0 +1,385 @@
+package intervaltree
+
+import (
+	"cmp"
+
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// node представляет узел дерева интервалов.
+type node[T any, V any] struct {
+	Entry  pair.Pair[Interval[T], V]
+	Seq    uint64 // Порядковый номер вставки, различает равные интервалы.
+	Max    T      // Максимальная правая граница интервалов поддерева.
+	Height int
+	Parent *node[T, V]
+	Left   *node[T, V]
+	Right  *node[T, V]
+}
+
+// next возвращает следующий узел в in-order порядке.
+func (n *node[T, V]) next() *node[T, V] {
+	if n.Right != nil {
+		return n.Right.min()
+	}
+	current := n
+	parent := n.Parent
+	for parent != nil && current == parent.Right {
+		current = parent
+		parent = parent.Parent
+	}
+	return parent
+}
+
+// min возвращает минимальный узел поддерева.
+func (n *node[T, V]) min() *node[T, V] {
+	for n.Left != nil {
+		n = n.Left
+	}
+	return n
+}
+
+// linkChildren проставляет узел родителем своих потомков.
+func (n *node[T, V]) linkChildren() {
+	if n.Left != nil {
+		n.Left.Parent = n
+	}
+	if n.Right != nil {
+		n.Right.Parent = n
+	}
+}
+
+// IntervalTree представляет собой дерево интервалов на основе AVL дерева.
+// Интервалы упорядочены по левой границе, затем по правой, равные интервалы - в порядке вставки.
+// Каждый узел хранит максимальную правую границу своего поддерева,
+// что позволяет находить пересекающиеся интервалы за O(log n + k), где k - размер ответа.
+type IntervalTree[T any, V any] struct {
+	root       *node[T, V]
+	size       uint
+	seq        uint64
+	comparator comparator.Comparator[T]
+}
+
+// NewIntervalTree создает новое дерево интервалов с заданным компаратором границ
+// и заполняет его переданными парами интервал-значение.
+func NewIntervalTree[T any, V any](
+	comparator comparator.Comparator[T],
+	items ...pair.Pair[Interval[T], V],
+) *IntervalTree[T, V] {
+	tree := &IntervalTree[T, V]{comparator: comparator}
+	for i := range items {
+		tree.Insert(items[i].First, items[i].Second)
+	}
+	return tree
+}
+
+// Size возвращает количество интервалов в дереве.
+func (tree *IntervalTree[T, V]) Size() uint {
+	return tree.size
+}
+
+// IsEmpty проверяет что дерево пустое.
+func (tree *IntervalTree[T, V]) IsEmpty() bool {
+	return tree.size == 0
+}
+
+// Clear удаляет все интервалы из дерева.
+func (tree *IntervalTree[T, V]) Clear() {
+	tree.root = nil
+	tree.size = 0
+}
+
+// compare сравнивает интервал a с порядковым номером seqA с узлом n.
+func (tree *IntervalTree[T, V]) compare(a Interval[T], seqA uint64, n *node[T, V]) int {
+	if c := tree.comparator(a.Low, n.Entry.First.Low); c != 0 {
+		return c
+	}
+	if c := tree.comparator(a.High, n.Entry.First.High); c != 0 {
+		return c
+	}
+	return cmp.Compare(seqA, n.Seq)
+}
+
+// height возвращает высоту узла
+func (tree *IntervalTree[T, V]) height(n *node[T, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.Height
+}
+
+// update пересчитывает высоту узла и максимальную правую границу его поддерева
+func (tree *IntervalTree[T, V]) update(n *node[T, V]) {
+	n.Height = max(tree.height(n.Left), tree.height(n.Right)) + 1
+	n.Max = n.Entry.First.High
+	if n.Left != nil && tree.comparator(n.Left.Max, n.Max) > 0 {
+		n.Max = n.Left.Max
+	}
+	if n.Right != nil && tree.comparator(n.Right.Max, n.Max) > 0 {
+		n.Max = n.Right.Max
+	}
+}
+
+// rightRotate выполняет правое вращение
+func (tree *IntervalTree[T, V]) rightRotate(y *node[T, V]) *node[T, V] {
+	x := y.Left
+	y.Left = x.Right
+	x.Right = y
+
+	x.Parent = y.Parent
+	y.linkChildren()
+	x.linkChildren()
+
+	tree.update(y)
+	tree.update(x)
+
+	return x
+}
+
+// leftRotate выполняет левое вращение
+func (tree *IntervalTree[T, V]) leftRotate(x *node[T, V]) *node[T, V] {
+	y := x.Right
+	x.Right = y.Left
+	y.Left = x
+
+	y.Parent = x.Parent
+	x.linkChildren()
+	y.linkChildren()
+
+	tree.update(x)
+	tree.update(y)
+
+	return y
+}
+
+// getBalance получает балансирующий фактор узла
+func (tree *IntervalTree[T, V]) getBalance(n *node[T, V]) int {
+	if n == nil {
+		return 0
+	}
+	return tree.height(n.Left) - tree.height(n.Right)
+}
+
+// rebalance пересчитывает служебные поля узла и восстанавливает баланс поддерева при необходимости.
+// Возвращает новый корень поддерева.
+func (tree *IntervalTree[T, V]) rebalance(n *node[T, V]) *node[T, V] {
+	n.linkChildren()
+	tree.update(n)
+
+	balance := tree.getBalance(n)
+	if balance > 1 {
+		// Левый правый случай сводится к левому левому
+		if tree.getBalance(n.Left) < 0 {
+			n.Left = tree.leftRotate(n.Left)
+		}
+		return tree.rightRotate(n)
+	}
+	if balance < -1 {
+		// Правый левый случай сводится к правому правому
+		if tree.getBalance(n.Right) > 0 {
+			n.Right = tree.rightRotate(n.Right)
+		}
+		return tree.leftRotate(n)
+	}
+
+	return n
+}
+
+// Insert вставляет интервал со значением в дерево. Равные и пустые интервалы допускаются,
+// но пустые интервалы перебираются только от Begin. Если левая граница интервала больше правой, возникает паника.
+func (tree *IntervalTree[T, V]) Insert(interval Interval[T], value V) {
+	if tree.comparator(interval.Low, interval.High) > 0 {
+		panic("interval low bound must not exceed high bound")
+	}
+	tree.seq++
+	n := &node[T, V]{Entry: pair.NewPair(interval, value), Seq: tree.seq}
+	tree.root = tree.insert(tree.root, n)
+	tree.root.Parent = nil
+	tree.size++
+}
+
+func (tree *IntervalTree[T, V]) insert(n *node[T, V], inserted *node[T, V]) *node[T, V] {
+	if n == nil {
+		tree.update(inserted)
+		return inserted
+	}
+
+	if tree.compare(inserted.Entry.First, inserted.Seq, n) < 0 {
+		n.Left = tree.insert(n.Left, inserted)
+	} else {
+		n.Right = tree.insert(n.Right, inserted)
+	}
+
+	return tree.rebalance(n)
+}
+
+// findFirst возвращает первый вставленный узел с интервалом, равным interval, или nil.
+func (tree *IntervalTree[T, V]) findFirst(interval Interval[T]) *node[T, V] {
+	var found *node[T, V]
+	for n := tree.root; n != nil; {
+		c := tree.comparator(interval.Low, n.Entry.First.Low)
+		if c == 0 {
+			c = tree.comparator(interval.High, n.Entry.First.High)
+		}
+		switch {
+		case c < 0:
+			n = n.Left
+		case c > 0:
+			n = n.Right
+		default:
+			found = n
+			n = n.Left
+		}
+	}
+	return found
+}
+
+// Contains проверяет, есть ли в дереве интервал, равный interval.
+func (tree *IntervalTree[T, V]) Contains(interval Interval[T]) bool {
+	return tree.findFirst(interval) != nil
+}
+
+// Delete удаляет из дерева первый вставленный интервал, равный interval.
+// Возвращает true в случае успешного удаления.
+func (tree *IntervalTree[T, V]) Delete(interval Interval[T]) bool {
+	target := tree.findFirst(interval)
+	if target == nil {
+		return false
+	}
+	tree.root = tree.delete(tree.root, target)
+	if tree.root != nil {
+		tree.root.Parent = nil
+	}
+	tree.size--
+	return true
+}
+
+func (tree *IntervalTree[T, V]) delete(n *node[T, V], target *node[T, V]) *node[T, V] {
+	switch c := tree.compare(target.Entry.First, target.Seq, n); {
+	case c < 0:
+		n.Left = tree.delete(n.Left, target)
+	case c > 0:
+		n.Right = tree.delete(n.Right, target)
+	default:
+		if n.Left == nil {
+			return n.Right
+		}
+		if n.Right == nil {
+			return n.Left
+		}
+		// Узел с двумя потомками заменяется минимальным узлом правого поддерева.
+		right, successor := tree.detachMin(n.Right)
+		successor.Left = n.Left
+		successor.Right = right
+		return tree.rebalance(successor)
+	}
+
+	return tree.rebalance(n)
+}
+
+// detachMin отсоединяет минимальный узел от поддерева.
+// Возвращает новый корень поддерева и отсоединенный узел.
+func (tree *IntervalTree[T, V]) detachMin(n *node[T, V]) (*node[T, V], *node[T, V]) {
+	if n.Left == nil {
+		return n.Right, n
+	}
+	var m *node[T, V]
+	n.Left, m = tree.detachMin(n.Left)
+	return tree.rebalance(n), m
+}
+
+// firstMatch возвращает первый в in-order порядке узел поддерева, удовлетворяющий запросу, или nil.
+func (tree *IntervalTree[T, V]) firstMatch(n *node[T, V], q *query[T]) *node[T, V] {
+	for n != nil && !q.prunes(tree.comparator, n.Max) {
+		if m := tree.firstMatch(n.Left, q); m != nil {
+			return m
+		}
+		if q.beyond(tree.comparator, n.Entry.First.Low) {
+			return nil
+		}
+		if q.matches(tree.comparator, n.Entry.First) {
+			return n
+		}
+		n = n.Right
+	}
+	return nil
+}
+
+// nextMatch возвращает следующий за n в in-order порядке узел, удовлетворяющий запросу, или nil.
+func (tree *IntervalTree[T, V]) nextMatch(n *node[T, V], q *query[T]) *node[T, V] {
+	if m := tree.firstMatch(n.Right, q); m != nil {
+		return m
+	}
+	// Поднимаемся к ближайшему предку, для которого n находится в левом поддереве.
+	for n.Parent != nil {
+		parent := n.Parent
+		if n == parent.Left {
+			if q.beyond(tree.comparator, parent.Entry.First.Low) {
+				return nil
+			}
+			if q.matches(tree.comparator, parent.Entry.First) {
+				return parent
+			}
+			if m := tree.firstMatch(parent.Right, q); m != nil {
+				return m
+			}
+		}
+		n = parent
+	}
+	return nil
+}
+
+// Begin возвращает итератор на первый интервал дерева.
+// Интервалы перебираются по возрастанию левой границы, затем правой.
+func (tree *IntervalTree[T, V]) Begin() interfaces.ForwardIterator[pair.Pair[Interval[T], V]] {
+	return newIterator(tree, &query[T]{all: true})
+}
+
+// OverlapBegin возвращает итератор по интервалам, пересекающимся с полуоткрытым интервалом [low, high).
+// Пустые интервалы не пересекаются ни с какими интервалами: они не попадают в ответ,
+// а для пустого интервала запроса возвращается итератор на конец дерева.
+// Интервалы перебираются в том же порядке, что и при обходе от Begin.
+func (tree *IntervalTree[T, V]) OverlapBegin(low, high T) interfaces.ForwardIterator[pair.Pair[Interval[T], V]] {
+	q := &query[T]{low: low, high: high}
+	if tree.comparator(low, high) >= 0 {
+		return &iterator[T, V]{tree: tree, q: q}
+	}
+	return newIterator(tree, q)
+}
+
+// ContainBegin возвращает итератор по интервалам, содержащим точку point.
+// Интервалы перебираются в том же порядке, что и при обходе от Begin.
+func (tree *IntervalTree[T, V]) ContainBegin(point T) interfaces.ForwardIterator[pair.Pair[Interval[T], V]] {
+	return newIterator(tree, &query[T]{low: point, high: point, closed: true})
+}
+
+// End возвращает итератор на конец дерева.
+func (tree *IntervalTree[T, V]) End() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию дерева.
+func (tree *IntervalTree[T, V]) Copy() copiable.Copiable {
+	return &IntervalTree[T, V]{
+		root:       cloneNode(tree.root, nil),
+		size:       tree.size,
+		seq:        tree.seq,
+		comparator: tree.comparator,
+	}
+}
+
+// cloneNode рекурсивно копирует поддерево, проставляя копии родителя parent.
+func cloneNode[T any, V any](n *node[T, V], parent *node[T, V]) *node[T, V] {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.Parent = parent
+	c.Left = cloneNode(n.Left, &c)
+	c.Right = cloneNode(n.Right, &c)
+	return &c
+}
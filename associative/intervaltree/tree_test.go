@@ -0,0 +1,88 @@
+package intervaltree
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// values собирает значения диапазона [begin, end).
+func values[T any, V any](begin interfaces.ForwardIterator[pair.Pair[Interval[T], V]], end interfaces.Iterator) []V {
+	var result []V
+	for it := begin; !it.Equals(end); it.Next() {
+		result = append(result, it.Value().Second)
+	}
+	return result
+}
+
+func TestEmptyIntervalsMatchNothing(t *testing.T) {
+	tree := NewIntervalTree[int, string](comparator.DefaultComparator[int]())
+	tree.Insert(NewInterval(1, 10), "wide")
+	tree.Insert(NewInterval(5, 5), "empty")
+
+	assert.Equal(t, []string{"wide", "empty"}, values(tree.Begin(), tree.End()))
+	assert.Equal(t, []string{"wide"}, values(tree.OverlapBegin(3, 8), tree.End()))
+	assert.Equal(t, []string{"wide"}, values(tree.ContainBegin(5), tree.End()))
+	assert.Empty(t, values(tree.OverlapBegin(5, 5), tree.End()))
+	assert.Empty(t, values(tree.OverlapBegin(7, 3), tree.End()))
+}
+
+func TestOverlapMatchesModel(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tree := NewIntervalTree[int, int](comparator.DefaultComparator[int]())
+	var model []Interval[int]
+	for i := 0; i < 300; i++ {
+		low := rnd.Intn(100)
+		iv := NewInterval(low, low+rnd.Intn(10))
+		tree.Insert(iv, i)
+		model = append(model, iv)
+	}
+
+	for i := 0; i < 300; i++ {
+		low := rnd.Intn(110) - 5
+		high := low + rnd.Intn(15) - 2
+		expected := make(map[int]bool)
+		for j, iv := range model {
+			if iv.Low < iv.High && low < high && low < iv.High && iv.Low < high {
+				expected[j] = true
+			}
+		}
+		actual := make(map[int]bool)
+		for _, v := range values(tree.OverlapBegin(low, high), tree.End()) {
+			actual[v] = true
+		}
+		assert.Equal(t, expected, actual, "query [%d, %d)", low, high)
+
+		expected = make(map[int]bool)
+		for j, iv := range model {
+			if iv.Low <= low && low < iv.High {
+				expected[j] = true
+			}
+		}
+		actual = make(map[int]bool)
+		for _, v := range values(tree.ContainBegin(low), tree.End()) {
+			actual[v] = true
+		}
+		assert.Equal(t, expected, actual, "point %d", low)
+	}
+}
+
+func TestIteratorNextOnEnd(t *testing.T) {
+	tree := NewIntervalTree[int, string](comparator.DefaultComparator[int]())
+	tree.Insert(NewInterval(1, 3), "a")
+
+	it := tree.OverlapBegin(0, 2)
+	it.Next()
+	assert.True(t, it.Equals(tree.End()))
+	it.Next()
+	assert.True(t, it.Equals(tree.End()))
+
+	it = tree.ContainBegin(10)
+	it.Next()
+	assert.True(t, it.Equals(tree.End()))
+}
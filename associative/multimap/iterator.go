@@ -0,0 +1,21 @@
+package multimap
+
+import (
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/internal/projection"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// newIterator создает итератор с произвольным доступом по элементам мультиотображения.
+// Адаптирует итератор дерева, возвращая пары ключ-значение.
+// Изменение ключа через Ptr нарушает упорядоченность мультиотображения.
+func newIterator[K any, V any](
+	it interfaces.RandomAccessIterator[pair.Pair[*entry[K, V], struct{}]],
+) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return projection.NewIterator(it, projectEntry[K, V])
+}
+
+// projectEntry возвращает пару ключ-значение элемента дерева.
+func projectEntry[K any, V any](item *pair.Pair[*entry[K, V], struct{}]) *pair.Pair[K, V] {
+	return &item.First.Pair
+}
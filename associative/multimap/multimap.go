@@ -0,0 +1,171 @@
+package multimap
+
+import (
+	"cmp"
+	"math"
+
+	"github.com/Delisa-sama/collections/associative/avltree"
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// entry представляет элемент мультиотображения.
+// Порядковый номер вставки seq различает равные ключи и задает их порядок.
+type entry[K any, V any] struct {
+	pair.Pair[K, V]
+	seq uint64
+}
+
+const (
+	// minSeq меньше порядкового номера любого элемента.
+	minSeq uint64 = 0
+	// maxSeq больше порядкового номера любого элемента.
+	maxSeq uint64 = math.MaxUint64
+)
+
+// MultiMap представляет собой упорядоченное отображение, допускающее несколько значений для одного ключа.
+// Основано на AVL дереве, элементы с равными ключами перебираются в порядке вставки.
+type MultiMap[K any, V any] struct {
+	tree *avltree.AVLTree[*entry[K, V], struct{}]
+	comp comparator.Comparator[K]
+	seq  uint64
+}
+
+// NewMultiMap создает новое мультиотображение с заданным компаратором ключей
+// и заполняет его переданными парами.
+func NewMultiMap[K any, V any](comp comparator.Comparator[K], items ...pair.Pair[K, V]) *MultiMap[K, V] {
+	m := &MultiMap[K, V]{
+		tree: avltree.NewAVLTree[*entry[K, V], struct{}](func(a, b *entry[K, V]) int {
+			if c := comp(a.First, b.First); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.seq, b.seq)
+		}),
+		comp: comp,
+	}
+	for i := range items {
+		m.Insert(items[i].First, items[i].Second)
+	}
+	return m
+}
+
+// Size возвращает количество элементов в мультиотображении.
+func (m *MultiMap[K, V]) Size() uint {
+	return m.tree.Size()
+}
+
+// IsEmpty проверяет что мультиотображение пустое.
+func (m *MultiMap[K, V]) IsEmpty() bool {
+	return m.tree.IsEmpty()
+}
+
+// Clear удаляет все элементы из мультиотображения.
+func (m *MultiMap[K, V]) Clear() {
+	m.tree.Clear()
+}
+
+// Insert добавляет пару ключ-значение. Элемент располагается после всех элементов с равным ключом.
+func (m *MultiMap[K, V]) Insert(key K, value V) {
+	m.seq++
+	m.tree.Insert(&entry[K, V]{Pair: pair.NewPair(key, value), seq: m.seq}, struct{}{})
+}
+
+// probe возвращает служебный элемент для поиска границ диапазона равных key ключей.
+func (m *MultiMap[K, V]) probe(key K, seq uint64) *entry[K, V] {
+	return &entry[K, V]{Pair: pair.Pair[K, V]{First: key}, seq: seq}
+}
+
+// Count возвращает количество элементов с ключом key.
+func (m *MultiMap[K, V]) Count(key K) uint {
+	return m.tree.Rank(m.probe(key, maxSeq)) - m.tree.Rank(m.probe(key, minSeq))
+}
+
+// Contains проверяет есть ли в мультиотображении элементы с ключом key.
+func (m *MultiMap[K, V]) Contains(key K) bool {
+	return m.Count(key) > 0
+}
+
+// Find возвращает итератор на первый вставленный элемент с ключом key.
+// Если ключ не найден, возвращает конечный итератор.
+func (m *MultiMap[K, V]) Find(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	it := m.tree.LowerBound(m.probe(key, minSeq))
+	if it.Equals(m.tree.InOrderEnd()) || m.comp(it.Value().First.First, key) != 0 {
+		return m.End()
+	}
+	return newIterator(it)
+}
+
+// EqualRange возвращает пару итераторов [begin, end) на все элементы с ключом key в порядке вставки.
+// Если ключ не найден, оба итератора равны.
+func (m *MultiMap[K, V]) EqualRange(key K) (
+	interfaces.RandomAccessIterator[pair.Pair[K, V]],
+	interfaces.RandomAccessIterator[pair.Pair[K, V]],
+) {
+	return m.LowerBound(key), m.UpperBound(key)
+}
+
+// LowerBound возвращает итератор на первый элемент, ключ которого не меньше key.
+func (m *MultiMap[K, V]) LowerBound(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return newIterator(m.tree.LowerBound(m.probe(key, minSeq)))
+}
+
+// UpperBound возвращает итератор на первый элемент, ключ которого больше key.
+func (m *MultiMap[K, V]) UpperBound(key K) interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return newIterator(m.tree.UpperBound(m.probe(key, maxSeq)))
+}
+
+// EraseOne удаляет первый вставленный элемент с ключом key.
+// Возвращает true в случае успешного удаления.
+func (m *MultiMap[K, V]) EraseOne(key K) bool {
+	it := m.tree.LowerBound(m.probe(key, minSeq))
+	if it.Equals(m.tree.InOrderEnd()) || m.comp(it.Value().First.First, key) != 0 {
+		return false
+	}
+	return m.tree.Delete(it.Value().First)
+}
+
+// EraseAll удаляет все элементы с ключом key.
+// Возвращает количество удаленных элементов.
+func (m *MultiMap[K, V]) EraseAll(key K) uint {
+	var erased uint
+	for m.EraseOne(key) {
+		erased++
+	}
+	return erased
+}
+
+// Begin возвращает итератор на первый элемент мультиотображения.
+func (m *MultiMap[K, V]) Begin() interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return newIterator(m.tree.InOrderBegin())
+}
+
+// End возвращает итератор на элемент после последнего.
+func (m *MultiMap[K, V]) End() interfaces.RandomAccessIterator[pair.Pair[K, V]] {
+	return newIterator(m.tree.InOrderEnd())
+}
+
+// RBegin возвращает перевернутый итератор на последний элемент мультиотображения.
+func (m *MultiMap[K, V]) RBegin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	it := m.End()
+	it.Prev()
+	return iterators.NewReverseIterator(it)
+}
+
+// REnd возвращает итератор на конец перевернутого мультиотображения.
+func (m *MultiMap[K, V]) REnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию мультиотображения. Порядок элементов с равными ключами сохраняется.
+func (m *MultiMap[K, V]) Copy() copiable.Copiable {
+	mapCopy := NewMultiMap[K, V](m.comp)
+	for it := m.tree.InOrderBegin(); !it.Equals(m.tree.InOrderEnd()); it.Next() {
+		e := *it.Value().First
+		mapCopy.tree.Insert(&e, struct{}{})
+	}
+	mapCopy.seq = m.seq
+	return mapCopy
+}
@@ -0,0 +1,58 @@
+package multimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// values собирает значения диапазона [begin, end).
+func values[K any, V any](begin interfaces.ForwardIterator[pair.Pair[K, V]], end interfaces.Iterator) []V {
+	var result []V
+	for it := begin; !it.Equals(end); it.Next() {
+		result = append(result, it.Value().Second)
+	}
+	return result
+}
+
+func TestEqualRangeOrderAfterEraseOne(t *testing.T) {
+	m := NewMultiMap[int, string](comparator.DefaultComparator[int]())
+	m.Insert(1, "a")
+	m.Insert(2, "b")
+	m.Insert(1, "c")
+	m.Insert(1, "d")
+	m.Insert(0, "e")
+	m.Insert(1, "f")
+
+	assert.Equal(t, []string{"a", "c", "d", "f"}, values(m.EqualRange(1)))
+
+	// EraseOne удаляет первый вставленный элемент, остальные сохраняют порядок вставки.
+	assert.True(t, m.EraseOne(1))
+	assert.Equal(t, []string{"c", "d", "f"}, values(m.EqualRange(1)))
+
+	// Элемент, вставленный после удаления, располагается после оставшихся.
+	m.Insert(1, "g")
+	assert.True(t, m.EraseOne(1))
+	assert.Equal(t, []string{"d", "f", "g"}, values(m.EqualRange(1)))
+	assert.Equal(t, uint(3), m.Count(1))
+
+	assert.Equal(t, []string{"e", "d", "f", "g", "b"}, values(m.Begin(), m.End()))
+	assert.Equal(t, uint(3), m.EraseAll(1))
+	assert.False(t, m.EraseOne(1))
+	begin, end := m.EqualRange(1)
+	assert.True(t, begin.Equals(end))
+}
+
+func TestRBegin(t *testing.T) {
+	m := NewMultiMap[int, string](comparator.DefaultComparator[int]())
+	assert.True(t, m.RBegin().Equals(m.REnd()))
+
+	m.Insert(2, "a")
+	m.Insert(1, "b")
+	m.Insert(2, "c")
+	assert.Equal(t, []string{"c", "a", "b"}, values(m.RBegin(), m.REnd()))
+}
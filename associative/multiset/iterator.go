@@ -0,0 +1,19 @@
+package multiset
+
+import (
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/internal/projection"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// newIterator создает итератор с произвольным доступом по элементам мультимножества.
+// Адаптирует итератор мультиотображения, возвращая только ключи.
+// Изменение значения через Ptr нарушает упорядоченность мультимножества.
+func newIterator[T any](it interfaces.RandomAccessIterator[pair.Pair[T, struct{}]]) interfaces.RandomAccessIterator[T] {
+	return projection.NewIterator(it, projectKey[T])
+}
+
+// projectKey возвращает ключ элемента мультиотображения.
+func projectKey[T any](item *pair.Pair[T, struct{}]) *T {
+	return &item.First
+}
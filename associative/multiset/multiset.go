@@ -0,0 +1,120 @@
+package multiset
+
+import (
+	"github.com/Delisa-sama/collections/associative/multimap"
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// MultiSet представляет собой упорядоченное множество, допускающее повторяющиеся элементы.
+// Равные элементы перебираются в порядке вставки.
+type MultiSet[T any] struct {
+	m *multimap.MultiMap[T, struct{}]
+}
+
+// NewMultiSet создает новое мультимножество с заданным компаратором и заполняет его переданными значениями.
+func NewMultiSet[T any](comp comparator.Comparator[T], items ...T) *MultiSet[T] {
+	s := &MultiSet[T]{
+		m: multimap.NewMultiMap[T, struct{}](comp),
+	}
+	for i := range items {
+		s.Insert(items[i])
+	}
+	return s
+}
+
+// Size возвращает количество элементов в мультимножестве.
+func (s *MultiSet[T]) Size() uint {
+	return s.m.Size()
+}
+
+// IsEmpty проверяет что мультимножество пустое.
+func (s *MultiSet[T]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Clear удаляет все элементы из мультимножества.
+func (s *MultiSet[T]) Clear() {
+	s.m.Clear()
+}
+
+// Insert добавляет значение в мультимножество. Значение располагается после всех равных ему.
+func (s *MultiSet[T]) Insert(value T) {
+	s.m.Insert(value, struct{}{})
+}
+
+// Count возвращает количество элементов, равных value.
+func (s *MultiSet[T]) Count(value T) uint {
+	return s.m.Count(value)
+}
+
+// Contains проверяет есть ли значение в мультимножестве.
+func (s *MultiSet[T]) Contains(value T) bool {
+	return s.m.Contains(value)
+}
+
+// Find возвращает итератор на первый вставленный элемент, равный value.
+// Если элемент не найден, возвращает конечный итератор.
+func (s *MultiSet[T]) Find(value T) interfaces.RandomAccessIterator[T] {
+	return newIterator(s.m.Find(value))
+}
+
+// EqualRange возвращает пару итераторов [begin, end) на все элементы, равные value, в порядке вставки.
+// Если элемент не найден, оба итератора равны.
+func (s *MultiSet[T]) EqualRange(value T) (interfaces.RandomAccessIterator[T], interfaces.RandomAccessIterator[T]) {
+	begin, end := s.m.EqualRange(value)
+	return newIterator(begin), newIterator(end)
+}
+
+// LowerBound возвращает итератор на первый элемент, не меньший value.
+func (s *MultiSet[T]) LowerBound(value T) interfaces.RandomAccessIterator[T] {
+	return newIterator(s.m.LowerBound(value))
+}
+
+// UpperBound возвращает итератор на первый элемент, больший value.
+func (s *MultiSet[T]) UpperBound(value T) interfaces.RandomAccessIterator[T] {
+	return newIterator(s.m.UpperBound(value))
+}
+
+// EraseOne удаляет первый вставленный элемент, равный value.
+// Возвращает true в случае успешного удаления.
+func (s *MultiSet[T]) EraseOne(value T) bool {
+	return s.m.EraseOne(value)
+}
+
+// EraseAll удаляет все элементы, равные value.
+// Возвращает количество удаленных элементов.
+func (s *MultiSet[T]) EraseAll(value T) uint {
+	return s.m.EraseAll(value)
+}
+
+// Begin возвращает итератор на первый элемент мультимножества.
+func (s *MultiSet[T]) Begin() interfaces.RandomAccessIterator[T] {
+	return newIterator(s.m.Begin())
+}
+
+// End возвращает итератор на элемент после последнего.
+func (s *MultiSet[T]) End() interfaces.RandomAccessIterator[T] {
+	return newIterator(s.m.End())
+}
+
+// RBegin возвращает перевернутый итератор на последний элемент мультимножества.
+func (s *MultiSet[T]) RBegin() interfaces.BidirectionalIterator[T] {
+	it := s.End()
+	it.Prev()
+	return iterators.NewReverseIterator(it)
+}
+
+// REnd возвращает итератор на конец перевернутого мультимножества.
+func (s *MultiSet[T]) REnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию мультимножества.
+func (s *MultiSet[T]) Copy() copiable.Copiable {
+	return &MultiSet[T]{
+		m: copiable.Copy[*multimap.MultiMap[T, struct{}]](s.m),
+	}
+}
@@ -0,0 +1,50 @@
+package multiset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// items собирает элементы диапазона [begin, end).
+func items[T any](begin interfaces.ForwardIterator[T], end interfaces.Iterator) []T {
+	var result []T
+	for it := begin; !it.Equals(end); it.Next() {
+		result = append(result, it.Value())
+	}
+	return result
+}
+
+// byFirst сравнивает пары только по первому элементу, второй элемент различает равные пары.
+func byFirst(a, b pair.Pair[int, string]) int {
+	return comparator.DefaultComparator[int]()(a.First, b.First)
+}
+
+func TestEqualRangeOrderAfterEraseOne(t *testing.T) {
+	s := NewMultiSet(byFirst,
+		pair.NewPair(1, "a"),
+		pair.NewPair(2, "b"),
+		pair.NewPair(1, "c"),
+		pair.NewPair(1, "d"),
+	)
+	assert.True(t, s.EraseOne(pair.NewPair(1, "")))
+	s.Insert(pair.NewPair(1, "e"))
+	assert.Equal(t,
+		[]pair.Pair[int, string]{pair.NewPair(1, "c"), pair.NewPair(1, "d"), pair.NewPair(1, "e")},
+		items(s.EqualRange(pair.NewPair(1, ""))),
+	)
+}
+
+func TestRBegin(t *testing.T) {
+	s := NewMultiSet[int](comparator.DefaultComparator[int]())
+	assert.True(t, s.RBegin().Equals(s.REnd()))
+
+	s.Insert(2)
+	s.Insert(1)
+	s.Insert(2)
+	assert.Equal(t, []int{2, 2, 1}, items(s.RBegin(), s.REnd()))
+}
@@ -0,0 +1,156 @@
+package roaring
+
+import "slices"
+
+// arrayContainer хранит элементы разреженного фрагмента в отсортированном массиве.
+type arrayContainer struct {
+	values []uint16
+}
+
+// cardinality возвращает количество элементов в контейнере.
+func (c *arrayContainer) cardinality() int {
+	return len(c.values)
+}
+
+// contains проверяет есть ли элемент в контейнере.
+func (c *arrayContainer) contains(x uint16) bool {
+	_, found := slices.BinarySearch(c.values, x)
+	return found
+}
+
+// add добавляет элемент и возвращает признак того, что его не было в контейнере.
+// Массив, превысивший arrayMaxSize элементов, преобразуется в битовую карту.
+func (c *arrayContainer) add(x uint16) (container, bool) {
+	i, found := slices.BinarySearch(c.values, x)
+	if found {
+		return c, false
+	}
+	if len(c.values) == arrayMaxSize {
+		b := c.toBitmap()
+		b.add(x)
+		return b, true
+	}
+	c.values = slices.Insert(c.values, i, x)
+	return c, true
+}
+
+// remove удаляет элемент и возвращает признак того, что он был в контейнере.
+func (c *arrayContainer) remove(x uint16) (container, bool) {
+	i, found := slices.BinarySearch(c.values, x)
+	if !found {
+		return c, false
+	}
+	c.values = slices.Delete(c.values, i, i+1)
+	return c, true
+}
+
+// rank возвращает количество элементов, не превосходящих x.
+func (c *arrayContainer) rank(x uint16) int {
+	i, found := slices.BinarySearch(c.values, x)
+	if found {
+		return i + 1
+	}
+	return i
+}
+
+// selectAt возвращает i-й по возрастанию элемент, нумерация с нуля.
+func (c *arrayContainer) selectAt(i int) uint16 {
+	return c.values[i]
+}
+
+// nextFrom возвращает наименьший элемент, не меньший x.
+func (c *arrayContainer) nextFrom(x uint16) (uint16, bool) {
+	i, _ := slices.BinarySearch(c.values, x)
+	if i == len(c.values) {
+		return 0, false
+	}
+	return c.values[i], true
+}
+
+// numRuns возвращает количество последовательностей подряд идущих элементов.
+func (c *arrayContainer) numRuns() int {
+	runs := 0
+	for i, v := range c.values {
+		if i == 0 || c.values[i-1]+1 != v {
+			runs++
+		}
+	}
+	return runs
+}
+
+// toBitmap возвращает новую битовую карту с элементами контейнера.
+func (c *arrayContainer) toBitmap() *bitmapContainer {
+	b := newBitmapContainer()
+	for _, v := range c.values {
+		b.words[v>>6] |= 1 << (v & 63)
+	}
+	b.card = len(c.values)
+	return b
+}
+
+// clone возвращает копию контейнера.
+func (c *arrayContainer) clone() container {
+	return &arrayContainer{values: slices.Clone(c.values)}
+}
+
+// filter возвращает новый массив из элементов, наличие которых в other совпадает с keep.
+func (c *arrayContainer) filter(other container, keep bool) container {
+	res := &arrayContainer{}
+	for _, v := range c.values {
+		if other.contains(v) == keep {
+			res.values = append(res.values, v)
+		}
+	}
+	return res
+}
+
+// or возвращает новый контейнер с объединением двух массивов.
+func (c *arrayContainer) or(other *arrayContainer) container {
+	res := &arrayContainer{values: make([]uint16, 0, len(c.values)+len(other.values))}
+	i, j := 0, 0
+	for i < len(c.values) && j < len(other.values) {
+		switch a, b := c.values[i], other.values[j]; {
+		case a < b:
+			res.values = append(res.values, a)
+			i++
+		case a > b:
+			res.values = append(res.values, b)
+			j++
+		default:
+			res.values = append(res.values, a)
+			i++
+			j++
+		}
+	}
+	res.values = append(res.values, c.values[i:]...)
+	res.values = append(res.values, other.values[j:]...)
+	if len(res.values) > arrayMaxSize {
+		return res.toBitmap()
+	}
+	return res
+}
+
+// xor возвращает новый контейнер с симметрической разностью двух массивов.
+func (c *arrayContainer) xor(other *arrayContainer) container {
+	res := &arrayContainer{}
+	i, j := 0, 0
+	for i < len(c.values) && j < len(other.values) {
+		switch a, b := c.values[i], other.values[j]; {
+		case a < b:
+			res.values = append(res.values, a)
+			i++
+		case a > b:
+			res.values = append(res.values, b)
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	res.values = append(res.values, c.values[i:]...)
+	res.values = append(res.values, other.values[j:]...)
+	if len(res.values) > arrayMaxSize {
+		return res.toBitmap()
+	}
+	return res
+}
@@ -0,0 +1,117 @@
+package roaring
+
+import (
+	"math/bits"
+	"slices"
+)
+
+// bitmapContainer хранит элементы плотного фрагмента в битовой карте из 2^16 бит.
+type bitmapContainer struct {
+	words []uint64
+	card  int
+}
+
+// newBitmapContainer создает пустую битовую карту.
+func newBitmapContainer() *bitmapContainer {
+	return &bitmapContainer{words: make([]uint64, bitmapWords)}
+}
+
+// cardinality возвращает количество элементов в контейнере.
+func (c *bitmapContainer) cardinality() int {
+	return c.card
+}
+
+// contains проверяет есть ли элемент в контейнере.
+func (c *bitmapContainer) contains(x uint16) bool {
+	return c.words[x>>6]&(1<<(x&63)) != 0
+}
+
+// add добавляет элемент и возвращает признак того, что его не было в контейнере.
+func (c *bitmapContainer) add(x uint16) (container, bool) {
+	w, mask := &c.words[x>>6], uint64(1)<<(x&63)
+	if *w&mask != 0 {
+		return c, false
+	}
+	*w |= mask
+	c.card++
+	return c, true
+}
+
+// remove удаляет элемент и возвращает признак того, что он был в контейнере.
+// Битовая карта, мощность которой не превышает arrayMaxSize, преобразуется в массив.
+func (c *bitmapContainer) remove(x uint16) (container, bool) {
+	w, mask := &c.words[x>>6], uint64(1)<<(x&63)
+	if *w&mask == 0 {
+		return c, false
+	}
+	*w &^= mask
+	c.card--
+	if c.card <= arrayMaxSize {
+		return toArray(c), true
+	}
+	return c, true
+}
+
+// rank возвращает количество элементов, не превосходящих x.
+func (c *bitmapContainer) rank(x uint16) int {
+	idx := int(x >> 6)
+	r := 0
+	for _, w := range c.words[:idx] {
+		r += bits.OnesCount64(w)
+	}
+	return r + bits.OnesCount64(c.words[idx]&(^uint64(0)>>(63-x&63)))
+}
+
+// selectAt возвращает i-й по возрастанию элемент, нумерация с нуля.
+func (c *bitmapContainer) selectAt(i int) uint16 {
+	for idx, w := range c.words {
+		n := bits.OnesCount64(w)
+		if i >= n {
+			i -= n
+			continue
+		}
+		for ; i > 0; i-- {
+			w &= w - 1
+		}
+		return uint16(idx<<6 + bits.TrailingZeros64(w))
+	}
+	panic("index out of range")
+}
+
+// nextFrom возвращает наименьший элемент, не меньший x.
+func (c *bitmapContainer) nextFrom(x uint16) (uint16, bool) {
+	idx := int(x >> 6)
+	w := c.words[idx] & (^uint64(0) << (x & 63))
+	for {
+		if w != 0 {
+			return uint16(idx<<6 + bits.TrailingZeros64(w)), true
+		}
+		idx++
+		if idx == bitmapWords {
+			return 0, false
+		}
+		w = c.words[idx]
+	}
+}
+
+// numRuns возвращает количество последовательностей подряд идущих элементов.
+func (c *bitmapContainer) numRuns() int {
+	runs := 0
+	var prev uint64
+	for _, w := range c.words {
+		// Начало последовательности - установленный бит, перед которым стоит сброшенный.
+		runs += bits.OnesCount64(w &^ (w<<1 | prev>>63))
+		prev = w
+	}
+	return runs
+}
+
+// toBitmap возвращает новую битовую карту с элементами контейнера.
+func (c *bitmapContainer) toBitmap() *bitmapContainer {
+	return &bitmapContainer{words: slices.Clone(c.words), card: c.card}
+}
+
+// clone возвращает копию контейнера.
+func (c *bitmapContainer) clone() container {
+	return c.toBitmap()
+}
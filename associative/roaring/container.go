@@ -0,0 +1,169 @@
+package roaring
+
+import "math/bits"
+
+const (
+	// arrayMaxSize - наибольшая мощность контейнера-массива. Более плотные фрагменты хранятся битовой картой.
+	arrayMaxSize = 4096
+	// bitmapWords - количество 64-битных слов битовой карты фрагмента из 2^16 значений.
+	bitmapWords = 1 << 16 / 64
+	// maxLow - наибольшее значение младших 16 бит элемента.
+	maxLow = 1<<16 - 1
+)
+
+// container хранит младшие 16 бит элементов одного фрагмента.
+// Методы, изменяющие контейнер, возвращают контейнер, в который он мог быть преобразован.
+type container interface {
+	// cardinality возвращает количество элементов в контейнере.
+	cardinality() int
+	// contains проверяет есть ли элемент в контейнере.
+	contains(x uint16) bool
+	// add добавляет элемент и возвращает признак того, что его не было в контейнере.
+	add(x uint16) (container, bool)
+	// remove удаляет элемент и возвращает признак того, что он был в контейнере.
+	remove(x uint16) (container, bool)
+	// rank возвращает количество элементов, не превосходящих x.
+	rank(x uint16) int
+	// selectAt возвращает i-й по возрастанию элемент, нумерация с нуля.
+	selectAt(i int) uint16
+	// nextFrom возвращает наименьший элемент, не меньший x.
+	nextFrom(x uint16) (uint16, bool)
+	// numRuns возвращает количество последовательностей подряд идущих элементов.
+	numRuns() int
+	// toBitmap возвращает новую битовую карту с элементами контейнера.
+	toBitmap() *bitmapContainer
+	// clone возвращает копию контейнера.
+	clone() container
+}
+
+// forEach вызывает f для каждого элемента контейнера в порядке возрастания.
+func forEach(c container, f func(uint16)) {
+	for v, ok := c.nextFrom(0); ok; v, ok = c.nextFrom(v + 1) {
+		f(v)
+		if v == maxLow {
+			return
+		}
+	}
+}
+
+// toArray возвращает новый контейнер-массив с элементами контейнера.
+func toArray(c container) *arrayContainer {
+	a := &arrayContainer{values: make([]uint16, 0, c.cardinality())}
+	forEach(c, func(v uint16) {
+		a.values = append(a.values, v)
+	})
+	return a
+}
+
+// toRun возвращает новый контейнер последовательностей с элементами контейнера.
+func toRun(c container) *runContainer {
+	r := &runContainer{runs: make([]interval, 0, c.numRuns())}
+	forEach(c, func(v uint16) {
+		if n := len(r.runs); n > 0 && uint32(r.runs[n-1].last)+1 == uint32(v) {
+			r.runs[n-1].last = v
+			return
+		}
+		r.runs = append(r.runs, interval{start: v, last: v})
+	})
+	return r
+}
+
+// optimize возвращает представление контейнера, занимающее меньше всего памяти.
+func optimize(c container) container {
+	card, runs := c.cardinality(), c.numRuns()
+	runSize, arraySize, bitmapSize := 4*runs, 2*card, 8*bitmapWords
+	switch {
+	case runSize < arraySize && runSize < bitmapSize:
+		if _, ok := c.(*runContainer); ok {
+			return c
+		}
+		return toRun(c)
+	case card <= arrayMaxSize:
+		if _, ok := c.(*arrayContainer); ok {
+			return c
+		}
+		return toArray(c)
+	default:
+		if _, ok := c.(*bitmapContainer); ok {
+			return c
+		}
+		return c.toBitmap()
+	}
+}
+
+// fromWords создает контейнер из слов битовой карты, выбирая массив или битовую карту по мощности.
+func fromWords(words []uint64) container {
+	card := 0
+	for _, w := range words {
+		card += bits.OnesCount64(w)
+	}
+	b := &bitmapContainer{words: words, card: card}
+	if card <= arrayMaxSize {
+		return toArray(b)
+	}
+	return b
+}
+
+// wordsOp применяет побитовую операцию op к битовым картам контейнеров a и b.
+func wordsOp(a, b container, op func(x, y uint64) uint64) container {
+	words := a.toBitmap().words
+	var other []uint64
+	if bm, ok := b.(*bitmapContainer); ok {
+		other = bm.words
+	} else {
+		other = b.toBitmap().words
+	}
+	for i := range words {
+		words[i] = op(words[i], other[i])
+	}
+	return fromWords(words)
+}
+
+// and возвращает новый контейнер с пересечением a и b.
+func and(a, b container) container {
+	if x, ok := a.(*arrayContainer); ok {
+		return x.filter(b, true)
+	}
+	if y, ok := b.(*arrayContainer); ok {
+		return y.filter(a, true)
+	}
+	if x, ok := a.(*runContainer); ok {
+		if y, ok := b.(*runContainer); ok {
+			return x.and(y)
+		}
+	}
+	return wordsOp(a, b, func(x, y uint64) uint64 { return x & y })
+}
+
+// or возвращает новый контейнер с объединением a и b.
+func or(a, b container) container {
+	if x, ok := a.(*arrayContainer); ok {
+		if y, ok := b.(*arrayContainer); ok {
+			return x.or(y)
+		}
+	}
+	if x, ok := a.(*runContainer); ok {
+		if y, ok := b.(*runContainer); ok {
+			return x.or(y)
+		}
+	}
+	return wordsOp(a, b, func(x, y uint64) uint64 { return x | y })
+}
+
+// andNot возвращает новый контейнер с элементами a, не содержащимися в b.
+func andNot(a, b container) container {
+	if x, ok := a.(*arrayContainer); ok {
+		return x.filter(b, false)
+	}
+	return wordsOp(a, b, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// xor возвращает новый контейнер с элементами, содержащимися ровно в одном из a и b.
+func xor(a, b container) container {
+	if x, ok := a.(*arrayContainer); ok {
+		if y, ok := b.(*arrayContainer); ok {
+			return x.xor(y)
+		}
+	}
+	return wordsOp(a, b, func(x, y uint64) uint64 { return x ^ y })
+}
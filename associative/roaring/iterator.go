@@ -0,0 +1,88 @@
+package roaring
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// iterator представляет собой прямой итератор по элементам множества в порядке возрастания.
+// Изменение множества инвалидирует итератор.
+type iterator struct {
+	b     *Bitmap
+	ci    int // Индекс текущего фрагмента.
+	value uint32
+}
+
+// newIterator создает итератор, указывающий на наименьший элемент множества.
+func newIterator(b *Bitmap) *iterator {
+	it := &iterator{b: b}
+	it.seek(0)
+	return it
+}
+
+// seek перемещает итератор на наименьший элемент фрагмента ci, не меньший low.
+// Если такого элемента нет, переходит к следующим фрагментам.
+func (it *iterator) seek(low uint16) {
+	for ; it.ci < len(it.b.keys); it.ci, low = it.ci+1, 0 {
+		if v, ok := it.b.containers[it.ci].nextFrom(low); ok {
+			it.value = join(it.b.keys[it.ci], v)
+			return
+		}
+	}
+}
+
+// isEnd проверяет, указывает ли итератор на конец множества.
+func (it *iterator) isEnd() bool {
+	return it.ci >= len(it.b.keys)
+}
+
+// HasNext проверяет, указывает ли итератор на элемент множества.
+func (it *iterator) HasNext() bool {
+	return !it.isEnd()
+}
+
+// Next переходит к следующему по возрастанию элементу.
+func (it *iterator) Next() {
+	_, low := split(it.value)
+	if low == maxLow {
+		it.ci++
+		it.seek(0)
+		return
+	}
+	it.seek(low + 1)
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator) Value() uint32 {
+	return it.value
+}
+
+// Ptr возвращает указатель на копию текущего значения итератора.
+func (it *iterator) Ptr() *uint32 {
+	v := it.value
+	return &v
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *iterator) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator:
+		if it.isEnd() || a.isEnd() {
+			return it.isEnd() == a.isEnd()
+		}
+		return it.ci == a.ci && it.value == a.value
+	case *iterators.EndIterator:
+		return it.isEnd()
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *iterator) Copy() copiable.Copiable {
+	return &iterator{
+		b:     it.b,
+		ci:    it.ci,
+		value: it.value,
+	}
+}
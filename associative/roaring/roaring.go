@@ -0,0 +1,256 @@
+package roaring
+
+import (
+	"slices"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// Bitmap представляет собой сжатое множество беззнаковых 32-битных целых (roaring bitmap).
+// Значения разбиваются на фрагменты по старшим 16 битам. Младшие биты каждого фрагмента хранятся
+// в отсортированном массиве, в битовой карте или в списке последовательностей - в зависимости от плотности.
+type Bitmap struct {
+	keys       []uint16
+	containers []container
+	card       uint64
+}
+
+// NewBitmap создает новое множество и заполняет его переданными значениями.
+func NewBitmap(values ...uint32) *Bitmap {
+	b := &Bitmap{}
+	for _, v := range values {
+		b.Add(v)
+	}
+	return b
+}
+
+// split разбивает значение на старшие и младшие 16 бит.
+func split(x uint32) (uint16, uint16) {
+	return uint16(x >> 16), uint16(x)
+}
+
+// join собирает значение из старших и младших 16 бит.
+func join(key, low uint16) uint32 {
+	return uint32(key)<<16 | uint32(low)
+}
+
+// find возвращает индекс фрагмента с ключом key и признак его наличия.
+// Если фрагмент не найден, возвращает индекс, по которому его следует вставить.
+func (b *Bitmap) find(key uint16) (int, bool) {
+	return slices.BinarySearch(b.keys, key)
+}
+
+// Cardinality возвращает количество элементов множества.
+func (b *Bitmap) Cardinality() uint64 {
+	return b.card
+}
+
+// Size возвращает количество элементов множества.
+func (b *Bitmap) Size() uint {
+	return uint(b.card)
+}
+
+// IsEmpty проверяет что множество пустое.
+func (b *Bitmap) IsEmpty() bool {
+	return b.card == 0
+}
+
+// Clear удаляет все элементы из множества.
+func (b *Bitmap) Clear() {
+	b.keys = nil
+	b.containers = nil
+	b.card = 0
+}
+
+// Add добавляет значение в множество.
+// Возвращает true, если значения не было в множестве.
+func (b *Bitmap) Add(x uint32) bool {
+	key, low := split(x)
+	i, found := b.find(key)
+	if !found {
+		b.keys = slices.Insert(b.keys, i, key)
+		b.containers = slices.Insert(b.containers, i, container(&arrayContainer{values: []uint16{low}}))
+		b.card++
+		return true
+	}
+	c, added := b.containers[i].add(low)
+	b.containers[i] = c
+	if added {
+		b.card++
+	}
+	return added
+}
+
+// Remove удаляет значение из множества.
+// Возвращает true в случае успешного удаления.
+func (b *Bitmap) Remove(x uint32) bool {
+	key, low := split(x)
+	i, found := b.find(key)
+	if !found {
+		return false
+	}
+	c, removed := b.containers[i].remove(low)
+	if !removed {
+		return false
+	}
+	b.card--
+	if c.cardinality() == 0 {
+		b.keys = slices.Delete(b.keys, i, i+1)
+		b.containers = slices.Delete(b.containers, i, i+1)
+		return true
+	}
+	b.containers[i] = c
+	return true
+}
+
+// Contains проверяет есть ли значение в множестве.
+func (b *Bitmap) Contains(x uint32) bool {
+	key, low := split(x)
+	i, found := b.find(key)
+	return found && b.containers[i].contains(low)
+}
+
+// Rank возвращает количество элементов множества, не превосходящих x.
+func (b *Bitmap) Rank(x uint32) uint64 {
+	key, low := split(x)
+	var r uint64
+	for i, k := range b.keys {
+		if k > key {
+			break
+		}
+		if k == key {
+			return r + uint64(b.containers[i].rank(low))
+		}
+		r += uint64(b.containers[i].cardinality())
+	}
+	return r
+}
+
+// Select возвращает i-й по возрастанию элемент множества, нумерация с нуля.
+// Если i не меньше мощности множества, возвращает false.
+func (b *Bitmap) Select(i uint64) (uint32, bool) {
+	if i >= b.card {
+		return 0, false
+	}
+	for ci, c := range b.containers {
+		card := uint64(c.cardinality())
+		if i < card {
+			return join(b.keys[ci], c.selectAt(int(i))), true
+		}
+		i -= card
+	}
+	return 0, false
+}
+
+// Min возвращает наименьший элемент множества. Если множество пустое, возвращает false.
+func (b *Bitmap) Min() (uint32, bool) {
+	if len(b.keys) == 0 {
+		return 0, false
+	}
+	low, _ := b.containers[0].nextFrom(0)
+	return join(b.keys[0], low), true
+}
+
+// Max возвращает наибольший элемент множества. Если множество пустое, возвращает false.
+func (b *Bitmap) Max() (uint32, bool) {
+	n := len(b.keys)
+	if n == 0 {
+		return 0, false
+	}
+	c := b.containers[n-1]
+	return join(b.keys[n-1], c.selectAt(c.cardinality()-1)), true
+}
+
+// merge заменяет содержимое множества результатом поэлементного слияния фрагментов с фрагментами other.
+// both вычисляет фрагмент, присутствующий в обоих множествах, keepOwn и keepOther определяют,
+// сохраняются ли фрагменты, присутствующие только в одном из множеств.
+func (b *Bitmap) merge(other *Bitmap, both func(a, b container) container, keepOwn, keepOther bool) {
+	keys := make([]uint16, 0, max(len(b.keys), len(other.keys)))
+	containers := make([]container, 0, cap(keys))
+	var card uint64
+	push := func(key uint16, c container) {
+		n := c.cardinality()
+		if n == 0 {
+			return
+		}
+		keys = append(keys, key)
+		containers = append(containers, c)
+		card += uint64(n)
+	}
+
+	i, j := 0, 0
+	for i < len(b.keys) || j < len(other.keys) {
+		switch {
+		case j == len(other.keys) || (i < len(b.keys) && b.keys[i] < other.keys[j]):
+			if keepOwn {
+				push(b.keys[i], b.containers[i])
+			}
+			i++
+		case i == len(b.keys) || other.keys[j] < b.keys[i]:
+			if keepOther {
+				push(other.keys[j], other.containers[j].clone())
+			}
+			j++
+		default:
+			push(b.keys[i], both(b.containers[i], other.containers[j]))
+			i++
+			j++
+		}
+	}
+	b.keys, b.containers, b.card = keys, containers, card
+}
+
+// And оставляет в множестве только элементы, содержащиеся в other.
+func (b *Bitmap) And(other *Bitmap) {
+	b.merge(other, and, false, false)
+}
+
+// Or добавляет в множество все элементы other.
+func (b *Bitmap) Or(other *Bitmap) {
+	b.merge(other, or, true, true)
+}
+
+// AndNot удаляет из множества все элементы, содержащиеся в other.
+func (b *Bitmap) AndNot(other *Bitmap) {
+	b.merge(other, andNot, true, false)
+}
+
+// Xor оставляет в множестве элементы, содержащиеся ровно в одном из множеств b и other.
+func (b *Bitmap) Xor(other *Bitmap) {
+	b.merge(other, xor, true, true)
+}
+
+// RunOptimize преобразует каждый фрагмент в представление, занимающее меньше всего памяти.
+// Списки последовательностей выбираются только этим методом, поэтому его стоит вызывать
+// после заполнения множества длинными диапазонами подряд идущих значений.
+func (b *Bitmap) RunOptimize() {
+	for i, c := range b.containers {
+		b.containers[i] = optimize(c)
+	}
+}
+
+// Begin возвращает итератор на наименьший элемент множества.
+// Элементы перебираются в порядке возрастания.
+func (b *Bitmap) Begin() interfaces.ForwardIterator[uint32] {
+	return newIterator(b)
+}
+
+// End возвращает итератор на конец множества.
+func (b *Bitmap) End() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию множества.
+func (b *Bitmap) Copy() copiable.Copiable {
+	containers := make([]container, len(b.containers))
+	for i, c := range b.containers {
+		containers[i] = c.clone()
+	}
+	return &Bitmap{
+		keys:       slices.Clone(b.keys),
+		containers: containers,
+		card:       b.card,
+	}
+}
@@ -0,0 +1,268 @@
+package roaring
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Delisa-sama/collections/copiable"
+)
+
+// model представляет собой множество-модель для сравнения с Bitmap.
+type model map[uint32]struct{}
+
+// sorted возвращает элементы модели в порядке возрастания.
+func (m model) sorted() []uint32 {
+	values := make([]uint32, 0, len(m))
+	for v := range m {
+		values = append(values, v)
+	}
+	slices.Sort(values)
+	return values
+}
+
+// randomValue возвращает значение из нескольких фрагментов: плотные диапазоны чередуются с редкими значениями.
+func randomValue(rnd *rand.Rand) uint32 {
+	key := uint32(rnd.Intn(3)) * 5
+	if rnd.Intn(2) == 0 {
+		return key<<16 | uint32(rnd.Intn(maxLow+1))
+	}
+	return key<<16 | uint32(1000+rnd.Intn(8000))
+}
+
+// checkInvariants проверяет упорядоченность фрагментов, отсутствие пустых контейнеров,
+// соответствие мощности и допустимые размеры контейнеров.
+func checkInvariants(t *testing.T, b *Bitmap) {
+	t.Helper()
+	require.Len(t, b.containers, len(b.keys))
+	require.True(t, slices.IsSorted(b.keys))
+	var card uint64
+	for i, c := range b.containers {
+		if i > 0 {
+			require.NotEqual(t, b.keys[i-1], b.keys[i])
+		}
+		n := c.cardinality()
+		require.Positive(t, n)
+		card += uint64(n)
+		switch c := c.(type) {
+		case *arrayContainer:
+			require.LessOrEqual(t, n, arrayMaxSize)
+			require.True(t, slices.IsSorted(c.values))
+		case *bitmapContainer:
+			require.Greater(t, n, arrayMaxSize)
+		case *runContainer:
+			for j, r := range c.runs {
+				require.LessOrEqual(t, r.start, r.last)
+				if j > 0 {
+					// Соседние последовательности не соприкасаются.
+					require.Greater(t, uint32(r.start), uint32(c.runs[j-1].last)+1)
+				}
+			}
+		}
+	}
+	require.Equal(t, b.card, card)
+}
+
+// checkModel сравнивает содержимое множества с моделью.
+func checkModel(t *testing.T, b *Bitmap, m model) {
+	t.Helper()
+	checkInvariants(t, b)
+	expected := m.sorted()
+	require.Equal(t, uint64(len(expected)), b.Cardinality())
+
+	var actual []uint32
+	for it := b.Begin(); !it.Equals(b.End()); it.Next() {
+		actual = append(actual, it.Value())
+	}
+	require.Equal(t, expected, actual)
+
+	if len(expected) == 0 {
+		_, ok := b.Min()
+		require.False(t, ok)
+		return
+	}
+	minValue, _ := b.Min()
+	maxValue, _ := b.Max()
+	require.Equal(t, expected[0], minValue)
+	require.Equal(t, expected[len(expected)-1], maxValue)
+	for _, i := range []int{0, len(expected) / 3, len(expected) / 2, len(expected) - 1} {
+		v, ok := b.Select(uint64(i))
+		require.True(t, ok)
+		require.Equal(t, expected[i], v)
+		require.Equal(t, uint64(i+1), b.Rank(expected[i]))
+	}
+	_, ok := b.Select(uint64(len(expected)))
+	require.False(t, ok)
+}
+
+// kinds возвращает количество контейнеров каждого вида.
+func kinds(b *Bitmap) (arrays, bitmaps, runs int) {
+	for _, c := range b.containers {
+		switch c.(type) {
+		case *arrayContainer:
+			arrays++
+		case *bitmapContainer:
+			bitmaps++
+		case *runContainer:
+			runs++
+		}
+	}
+	return arrays, bitmaps, runs
+}
+
+func TestArrayBitmapConversion(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	b := NewBitmap()
+	m := make(model)
+
+	// Заполнение фрагмента сверх arrayMaxSize преобразует массив в битовую карту.
+	for len(m) <= arrayMaxSize+100 {
+		v := uint32(rnd.Intn(maxLow + 1))
+		_, exists := m[v]
+		require.Equal(t, !exists, b.Add(v))
+		m[v] = struct{}{}
+	}
+	arrays, bitmaps, _ := kinds(b)
+	require.Equal(t, 0, arrays)
+	require.Equal(t, 1, bitmaps)
+	checkModel(t, b, m)
+
+	// Удаление до arrayMaxSize преобразует битовую карту обратно в массив.
+	for _, v := range m.sorted()[:101] {
+		require.True(t, b.Remove(v))
+		delete(m, v)
+	}
+	arrays, bitmaps, _ = kinds(b)
+	require.Equal(t, 1, arrays)
+	require.Equal(t, 0, bitmaps)
+	checkModel(t, b, m)
+}
+
+func TestRunOptimizeConversion(t *testing.T) {
+	b := NewBitmap()
+	m := make(model)
+	for v := uint32(100); v < 20000; v++ {
+		b.Add(v)
+		m[v] = struct{}{}
+	}
+	for v := uint32(1 << 16); v < 1<<16+10; v++ {
+		b.Add(v)
+		m[v] = struct{}{}
+	}
+	b.RunOptimize()
+	_, _, runs := kinds(b)
+	require.Equal(t, 2, runs)
+	checkModel(t, b, m)
+
+	// Изменения контейнера последовательностей, в том числе разбиение последовательности.
+	for _, v := range []uint32{100, 19999, 5000, 5001, 7000, 1 << 16, 1<<16 + 5} {
+		require.True(t, b.Remove(v))
+		delete(m, v)
+	}
+	for _, v := range []uint32{5000, 99, 20000, 30000, 1 << 16} {
+		require.True(t, b.Add(v))
+		m[v] = struct{}{}
+	}
+	checkModel(t, b, m)
+
+	// Редкие значения преобразуются обратно в массив, плотные - в битовую карту.
+	for v := uint32(2 << 16); v < 2<<16+50000; v += 7 {
+		b.Add(v)
+		m[v] = struct{}{}
+	}
+	b.RunOptimize()
+	arrays, bitmaps, runs := kinds(b)
+	require.Equal(t, 0, arrays)
+	require.Equal(t, 1, bitmaps)
+	require.Equal(t, 2, runs)
+	checkModel(t, b, m)
+}
+
+func TestRandomOperations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	b := NewBitmap()
+	m := make(model)
+	for step := 0; step < 200000; step++ {
+		v := randomValue(rnd)
+		switch op := rnd.Intn(100); {
+		case op < 60:
+			_, exists := m[v]
+			require.Equal(t, !exists, b.Add(v))
+			m[v] = struct{}{}
+		case op < 99:
+			_, exists := m[v]
+			require.Equal(t, exists, b.Remove(v))
+			delete(m, v)
+		default:
+			b.RunOptimize()
+		}
+		_, exists := m[v]
+		require.Equal(t, exists, b.Contains(v))
+		if step%20000 == 0 {
+			checkModel(t, b, m)
+		}
+	}
+	checkModel(t, b, m)
+}
+
+// randomBitmap возвращает случайное множество с контейнерами разных видов и его модель.
+func randomBitmap(rnd *rand.Rand) (*Bitmap, model) {
+	b := NewBitmap()
+	m := make(model)
+	for n := rnd.Intn(20000); n > 0; n-- {
+		v := randomValue(rnd)
+		b.Add(v)
+		m[v] = struct{}{}
+	}
+	// Плотный диапазон становится последовательностью после RunOptimize.
+	start := uint32(rnd.Intn(3)) * 5 << 16
+	for v := start; v < start+uint32(rnd.Intn(10000)); v++ {
+		b.Add(v)
+		m[v] = struct{}{}
+	}
+	if rnd.Intn(2) == 0 {
+		b.RunOptimize()
+	}
+	return b, m
+}
+
+func TestSetOperations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	operations := map[string]struct {
+		apply func(a, b *Bitmap)
+		keep  func(inA, inB bool) bool
+	}{
+		"and":    {(*Bitmap).And, func(inA, inB bool) bool { return inA && inB }},
+		"or":     {(*Bitmap).Or, func(inA, inB bool) bool { return inA || inB }},
+		"andNot": {(*Bitmap).AndNot, func(inA, inB bool) bool { return inA && !inB }},
+		"xor":    {(*Bitmap).Xor, func(inA, inB bool) bool { return inA != inB }},
+	}
+	for i := 0; i < 20; i++ {
+		a, ma := randomBitmap(rnd)
+		b, mb := randomBitmap(rnd)
+		for name, op := range operations {
+			expected := make(model)
+			for v := range ma {
+				if _, inB := mb[v]; op.keep(true, inB) {
+					expected[v] = struct{}{}
+				}
+			}
+			for v := range mb {
+				if _, inA := ma[v]; op.keep(inA, true) {
+					expected[v] = struct{}{}
+				}
+			}
+
+			result := copiable.Copy[*Bitmap](a)
+			op.apply(result, b)
+			t.Run(name, func(t *testing.T) {
+				checkModel(t, result, expected)
+				// Операция не изменяет свои аргументы.
+				checkModel(t, a, ma)
+				checkModel(t, b, mb)
+			})
+		}
+	}
+}
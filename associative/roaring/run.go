@@ -0,0 +1,188 @@
+package roaring
+
+import "sort"
+
+// interval - последовательность подряд идущих элементов [start, last].
+type interval struct {
+	start uint16
+	last  uint16
+}
+
+// runContainer хранит элементы фрагмента отсортированным списком непересекающихся последовательностей.
+type runContainer struct {
+	runs []interval
+}
+
+// search возвращает индекс первой последовательности, последний элемент которой не меньше x.
+func (c *runContainer) search(x uint16) int {
+	return sort.Search(len(c.runs), func(i int) bool {
+		return c.runs[i].last >= x
+	})
+}
+
+// cardinality возвращает количество элементов в контейнере.
+func (c *runContainer) cardinality() int {
+	card := 0
+	for _, r := range c.runs {
+		card += int(r.last-r.start) + 1
+	}
+	return card
+}
+
+// contains проверяет есть ли элемент в контейнере.
+func (c *runContainer) contains(x uint16) bool {
+	i := c.search(x)
+	return i < len(c.runs) && c.runs[i].start <= x
+}
+
+// add добавляет элемент и возвращает признак того, что его не было в контейнере.
+func (c *runContainer) add(x uint16) (container, bool) {
+	i := c.search(x)
+	if i < len(c.runs) && c.runs[i].start <= x {
+		return c, false
+	}
+	// Последовательность i начинается после x, а последовательность i-1 заканчивается до x.
+	joinPrev := i > 0 && c.runs[i-1].last+1 == x
+	joinNext := i < len(c.runs) && c.runs[i].start == x+1
+	switch {
+	case joinPrev && joinNext:
+		c.runs[i-1].last = c.runs[i].last
+		c.runs = append(c.runs[:i], c.runs[i+1:]...)
+	case joinPrev:
+		c.runs[i-1].last = x
+	case joinNext:
+		c.runs[i].start = x
+	default:
+		c.runs = append(c.runs, interval{})
+		copy(c.runs[i+1:], c.runs[i:])
+		c.runs[i] = interval{start: x, last: x}
+	}
+	return c, true
+}
+
+// remove удаляет элемент и возвращает признак того, что он был в контейнере.
+func (c *runContainer) remove(x uint16) (container, bool) {
+	i := c.search(x)
+	if i == len(c.runs) || c.runs[i].start > x {
+		return c, false
+	}
+	r := c.runs[i]
+	switch {
+	case r.start == r.last:
+		c.runs = append(c.runs[:i], c.runs[i+1:]...)
+	case x == r.start:
+		c.runs[i].start++
+	case x == r.last:
+		c.runs[i].last--
+	default:
+		// Удаление из середины разбивает последовательность на две.
+		c.runs[i].last = x - 1
+		c.runs = append(c.runs, interval{})
+		copy(c.runs[i+2:], c.runs[i+1:])
+		c.runs[i+1] = interval{start: x + 1, last: r.last}
+	}
+	return c, true
+}
+
+// rank возвращает количество элементов, не превосходящих x.
+func (c *runContainer) rank(x uint16) int {
+	r := 0
+	for _, run := range c.runs {
+		if run.start > x {
+			break
+		}
+		r += int(min(run.last, x)-run.start) + 1
+	}
+	return r
+}
+
+// selectAt возвращает i-й по возрастанию элемент, нумерация с нуля.
+func (c *runContainer) selectAt(i int) uint16 {
+	for _, run := range c.runs {
+		n := int(run.last-run.start) + 1
+		if i < n {
+			return run.start + uint16(i)
+		}
+		i -= n
+	}
+	panic("index out of range")
+}
+
+// nextFrom возвращает наименьший элемент, не меньший x.
+func (c *runContainer) nextFrom(x uint16) (uint16, bool) {
+	i := c.search(x)
+	if i == len(c.runs) {
+		return 0, false
+	}
+	return max(c.runs[i].start, x), true
+}
+
+// numRuns возвращает количество последовательностей подряд идущих элементов.
+func (c *runContainer) numRuns() int {
+	return len(c.runs)
+}
+
+// toBitmap возвращает новую битовую карту с элементами контейнера.
+func (c *runContainer) toBitmap() *bitmapContainer {
+	b := newBitmapContainer()
+	for _, run := range c.runs {
+		for v := uint32(run.start); v <= uint32(run.last); {
+			if v&63 == 0 && v+63 <= uint32(run.last) {
+				b.words[v>>6] = ^uint64(0)
+				v += 64
+				continue
+			}
+			b.words[v>>6] |= 1 << (v & 63)
+			v++
+		}
+	}
+	b.card = c.cardinality()
+	return b
+}
+
+// clone возвращает копию контейнера.
+func (c *runContainer) clone() container {
+	return &runContainer{runs: append([]interval(nil), c.runs...)}
+}
+
+// and возвращает новый контейнер с пересечением последовательностей.
+func (c *runContainer) and(other *runContainer) container {
+	res := &runContainer{}
+	i, j := 0, 0
+	for i < len(c.runs) && j < len(other.runs) {
+		a, b := c.runs[i], other.runs[j]
+		if start, last := max(a.start, b.start), min(a.last, b.last); start <= last {
+			res.runs = append(res.runs, interval{start: start, last: last})
+		}
+		if a.last < b.last {
+			i++
+		} else {
+			j++
+		}
+	}
+	return res
+}
+
+// or возвращает новый контейнер с объединением последовательностей.
+func (c *runContainer) or(other *runContainer) container {
+	res := &runContainer{runs: make([]interval, 0, len(c.runs)+len(other.runs))}
+	push := func(run interval) {
+		n := len(res.runs)
+		if n > 0 && uint32(run.start) <= uint32(res.runs[n-1].last)+1 {
+			res.runs[n-1].last = max(res.runs[n-1].last, run.last)
+			return
+		}
+		res.runs = append(res.runs, run)
+	}
+	i, j := 0, 0
+	for i < len(c.runs) || j < len(other.runs) {
+		if j == len(other.runs) || (i < len(c.runs) && c.runs[i].start <= other.runs[j].start) {
+			push(c.runs[i])
+			i++
+		} else {
+			push(other.runs[j])
+			j++
+		}
+	}
+	return res
+}
@@ -86,3 +86,97 @@ func (s *Set[K]) Erase(begin, end interfaces.Iterator) {
 		s.m.Delete(it.Key)
 	}
 }
+
+// Delete удаляет элемент из множества.
+// Возвращает true в случае успешного удаления.
+func (s *Set[K]) Delete(k K) bool {
+	return s.m.Delete(k)
+}
+
+// Clear удаляет все элементы из множества.
+func (s *Set[K]) Clear() {
+	s.m = orderedmap.NewOrderedMap[K, struct{}]()
+}
+
+// Union возвращает новое множество, содержащее элементы обоих множеств.
+// Сначала следуют элементы s в порядке вставки, затем отсутствующие в s элементы other.
+func (s *Set[K]) Union(other *Set[K]) *Set[K] {
+	result := &Set[K]{m: s.m.Copy()}
+	for el := other.m.Front(); el != nil; el = el.Next() {
+		result.m.Set(el.Key, struct{}{})
+	}
+	return result
+}
+
+// Intersection возвращает новое множество, содержащее элементы s, которые есть в other.
+// Порядок элементов соответствует порядку вставки в s.
+func (s *Set[K]) Intersection(other *Set[K]) *Set[K] {
+	result := NewSet[K]()
+	for el := s.m.Front(); el != nil; el = el.Next() {
+		if other.Contains(el.Key) {
+			result.m.Set(el.Key, struct{}{})
+		}
+	}
+	return result
+}
+
+// Difference возвращает новое множество, содержащее элементы s, которых нет в other.
+// Порядок элементов соответствует порядку вставки в s.
+func (s *Set[K]) Difference(other *Set[K]) *Set[K] {
+	result := NewSet[K]()
+	for el := s.m.Front(); el != nil; el = el.Next() {
+		if !other.Contains(el.Key) {
+			result.m.Set(el.Key, struct{}{})
+		}
+	}
+	return result
+}
+
+// SymmetricDifference возвращает новое множество, содержащее элементы, которые есть ровно в одном из множеств.
+// Сначала следуют элементы s в порядке вставки, затем элементы other.
+func (s *Set[K]) SymmetricDifference(other *Set[K]) *Set[K] {
+	result := s.Difference(other)
+	for el := other.m.Front(); el != nil; el = el.Next() {
+		if !s.Contains(el.Key) {
+			result.m.Set(el.Key, struct{}{})
+		}
+	}
+	return result
+}
+
+// IsSubsetOf проверяет что все элементы s содержатся в other.
+func (s *Set[K]) IsSubsetOf(other *Set[K]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for el := s.m.Front(); el != nil; el = el.Next() {
+		if !other.Contains(el.Key) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf проверяет что все элементы other содержатся в s.
+func (s *Set[K]) IsSupersetOf(other *Set[K]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsDisjoint проверяет что множества не имеют общих элементов.
+func (s *Set[K]) IsDisjoint(other *Set[K]) bool {
+	smaller, bigger := s, other
+	if smaller.Size() > bigger.Size() {
+		smaller, bigger = bigger, smaller
+	}
+	for el := smaller.m.Front(); el != nil; el = el.Next() {
+		if bigger.Contains(el.Key) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal проверяет что множества состоят из одних и тех же элементов, независимо от порядка вставки.
+func (s *Set[K]) Equal(other *Set[K]) bool {
+	return s.Size() == other.Size() && s.IsSubsetOf(other)
+}
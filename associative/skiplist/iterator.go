@@ -0,0 +1,80 @@
+package skiplist
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// iterator представляет собой двунаправленный итератор по нижнему уровню списка с пропусками.
+type iterator[T any] struct {
+	list    *SkipList[T]
+	current *node[T]
+}
+
+// newIterator создает новый итератор, указывающий на узел n. Значение nil соответствует концу списка.
+func newIterator[T any](l *SkipList[T], n *node[T]) *iterator[T] {
+	return &iterator[T]{
+		list:    l,
+		current: n,
+	}
+}
+
+// HasNext проверяет, указывает ли итератор на элемент списка.
+func (it *iterator[T]) HasNext() bool {
+	return it.current != nil
+}
+
+// Next переходит к следующему элементу.
+// Для итератора, указывающего на конец списка, ничего не делает.
+func (it *iterator[T]) Next() {
+	if it.current == nil {
+		return
+	}
+	it.current = it.current.Next[0]
+}
+
+// HasPrev проверяет, есть ли предыдущий элемент.
+func (it *iterator[T]) HasPrev() bool {
+	if it.current == nil {
+		return it.list.tail != nil
+	}
+	return it.current.Prev != nil
+}
+
+// Prev переходит к предыдущему элементу.
+// Переход назад от конца списка перемещает итератор на последний элемент.
+func (it *iterator[T]) Prev() {
+	if it.current == nil {
+		it.current = it.list.tail
+		return
+	}
+	it.current = it.current.Prev
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator[T]) Value() T {
+	return it.current.Value
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+// Изменение значения через указатель может нарушить упорядоченность списка.
+func (it *iterator[T]) Ptr() *T {
+	return &it.current.Value
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *iterator[T]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[T]:
+		return it.current == a.current
+	case *iterators.EndIterator:
+		return it.current == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *iterator[T]) Copy() copiable.Copiable {
+	return newIterator(it.list, it.current)
+}
@@ -0,0 +1,257 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+const (
+	// MaxLevel - максимальное количество уровней списка.
+	MaxLevel = 32
+
+	// levelBits - количество случайных бит, определяющих переход на следующий уровень.
+	// Узел поднимается на следующий уровень с вероятностью 1/4.
+	levelBits = 2
+)
+
+// node представляет собой узел списка с пропусками.
+type node[T any] struct {
+	Value T
+	Prev  *node[T]   // Предыдущий узел нижнего уровня.
+	Next  []*node[T] // Следующие узлы на каждом из уровней узла.
+}
+
+// SkipList представляет собой упорядоченный список с пропусками.
+// Равные элементы допускаются и располагаются в порядке вставки.
+// Нижний уровень является двусвязным списком, поэтому список можно обходить в обоих направлениях.
+type SkipList[T any] struct {
+	head       *node[T] // Фиктивный узел, содержащий ссылки на первые узлы всех уровней.
+	tail       *node[T]
+	level      int
+	size       uint
+	comparator comparator.Comparator[T]
+	source     rand.Source
+}
+
+// NewSkipList создает новый список с пропусками с заданным компаратором и заполняет его переданными значениями.
+func NewSkipList[T any](comp comparator.Comparator[T], values ...T) *SkipList[T] {
+	return NewSkipListWithSource(comp, rand.NewPCG(rand.Uint64(), rand.Uint64()), values...)
+}
+
+// NewSkipListWithSource создает новый список с пропусками, использующий source для выбора высоты узлов,
+// и заполняет его переданными значениями. Детерминированный источник дает детерминированную структуру списка.
+func NewSkipListWithSource[T any](comp comparator.Comparator[T], source rand.Source, values ...T) *SkipList[T] {
+	l := &SkipList[T]{
+		head:       &node[T]{Next: make([]*node[T], MaxLevel)},
+		level:      1,
+		comparator: comp,
+		source:     source,
+	}
+	for _, v := range values {
+		l.Insert(v)
+	}
+	return l
+}
+
+// Size возвращает количество элементов в списке.
+func (l *SkipList[T]) Size() uint {
+	return l.size
+}
+
+// IsEmpty проверяет что список пустой.
+func (l *SkipList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Clear удаляет все элементы из списка.
+func (l *SkipList[T]) Clear() {
+	clear(l.head.Next)
+	l.tail = nil
+	l.level = 1
+	l.size = 0
+}
+
+// randomLevel возвращает случайную высоту нового узла.
+func (l *SkipList[T]) randomLevel() int {
+	level := 1
+	for r := l.source.Uint64(); r&(1<<levelBits-1) == 0 && level < MaxLevel; r >>= levelBits {
+		level++
+	}
+	return level
+}
+
+// predecessors заполняет update последними узлами каждого уровня, для которых stop возвращает false.
+// Возвращает узел нижнего уровня, следующий за найденным.
+func (l *SkipList[T]) predecessors(update []*node[T], stop func(c int) bool, value T) *node[T] {
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.Next[i] != nil && !stop(l.comparator(x.Next[i].Value, value)) {
+			x = x.Next[i]
+		}
+		if update != nil {
+			update[i] = x
+		}
+	}
+	return x.Next[0]
+}
+
+// notLess - условие остановки поиска на первом элементе, не меньшем искомого.
+func notLess(c int) bool {
+	return c >= 0
+}
+
+// greater - условие остановки поиска на первом элементе, большем искомого.
+func greater(c int) bool {
+	return c > 0
+}
+
+// Insert вставляет значение в список после всех равных ему элементов.
+func (l *SkipList[T]) Insert(value T) {
+	var update [MaxLevel]*node[T]
+	next := l.predecessors(update[:], greater, value)
+
+	level := l.randomLevel()
+	for i := l.level; i < level; i++ {
+		update[i] = l.head
+	}
+	l.level = max(l.level, level)
+
+	n := &node[T]{Value: value, Next: make([]*node[T], level)}
+	for i := 0; i < level; i++ {
+		n.Next[i] = update[i].Next[i]
+		update[i].Next[i] = n
+	}
+
+	if update[0] != l.head {
+		n.Prev = update[0]
+	}
+	if next != nil {
+		next.Prev = n
+	} else {
+		l.tail = n
+	}
+	l.size++
+}
+
+// Delete удаляет из списка первый элемент, равный value.
+// Возвращает true в случае успешного удаления.
+func (l *SkipList[T]) Delete(value T) bool {
+	var update [MaxLevel]*node[T]
+	n := l.predecessors(update[:], notLess, value)
+	if n == nil || l.comparator(n.Value, value) != 0 {
+		return false
+	}
+
+	for i := range n.Next {
+		update[i].Next[i] = n.Next[i]
+	}
+	if n.Next[0] != nil {
+		n.Next[0].Prev = n.Prev
+	} else {
+		l.tail = n.Prev
+	}
+	for l.level > 1 && l.head.Next[l.level-1] == nil {
+		l.level--
+	}
+	l.size--
+	return true
+}
+
+// Find возвращает итератор на первый элемент, равный value.
+// Если элемент не найден, возвращает конечный итератор.
+func (l *SkipList[T]) Find(value T) interfaces.BidirectionalIterator[T] {
+	n := l.predecessors(nil, notLess, value)
+	if n == nil || l.comparator(n.Value, value) != 0 {
+		return l.End()
+	}
+	return newIterator(l, n)
+}
+
+// Contains проверяет есть ли в списке элемент, равный value.
+func (l *SkipList[T]) Contains(value T) bool {
+	n := l.predecessors(nil, notLess, value)
+	return n != nil && l.comparator(n.Value, value) == 0
+}
+
+// LowerBound возвращает итератор на первый элемент, не меньший value.
+func (l *SkipList[T]) LowerBound(value T) interfaces.BidirectionalIterator[T] {
+	return newIterator(l, l.predecessors(nil, notLess, value))
+}
+
+// UpperBound возвращает итератор на первый элемент, больший value.
+func (l *SkipList[T]) UpperBound(value T) interfaces.BidirectionalIterator[T] {
+	return newIterator(l, l.predecessors(nil, greater, value))
+}
+
+// Front возвращает минимальный элемент списка.
+func (l *SkipList[T]) Front() T {
+	return l.head.Next[0].Value
+}
+
+// Back возвращает максимальный элемент списка.
+func (l *SkipList[T]) Back() T {
+	return l.tail.Value
+}
+
+// Begin возвращает итератор на первый элемент списка.
+func (l *SkipList[T]) Begin() interfaces.BidirectionalIterator[T] {
+	return newIterator(l, l.head.Next[0])
+}
+
+// End возвращает итератор на элемент после последнего.
+// Переход назад от конечного итератора возвращает итератор на последний элемент.
+func (l *SkipList[T]) End() interfaces.BidirectionalIterator[T] {
+	return newIterator[T](l, nil)
+}
+
+// RBegin возвращает перевернутый итератор на последний элемент списка.
+func (l *SkipList[T]) RBegin() interfaces.BidirectionalIterator[T] {
+	return iterators.NewReverseIterator[T](newIterator(l, l.tail))
+}
+
+// REnd возвращает итератор на конец перевернутого списка.
+func (l *SkipList[T]) REnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию списка. Копия сохраняет высоты узлов.
+// Источник случайных чисел не разделяется между списками: копия получает новый источник,
+// инициализированный значениями источника исходного списка, поэтому для детерминированного источника
+// копирование остается детерминированным. Чтобы задать источник копии явно, используйте CopyWithSource.
+func (l *SkipList[T]) Copy() copiable.Copiable {
+	return l.CopyWithSource(rand.NewPCG(l.source.Uint64(), l.source.Uint64()))
+}
+
+// CopyWithSource возвращает копию списка, использующую source для выбора высоты новых узлов.
+// Копия сохраняет высоты узлов исходного списка.
+// Источник не должен использоваться другими списками: rand.Source не безопасен для конкурентного использования.
+func (l *SkipList[T]) CopyWithSource(source rand.Source) *SkipList[T] {
+	c := &SkipList[T]{
+		head:       &node[T]{Next: make([]*node[T], MaxLevel)},
+		level:      l.level,
+		size:       l.size,
+		comparator: l.comparator,
+		source:     source,
+	}
+
+	var last [MaxLevel]*node[T]
+	for i := range last {
+		last[i] = c.head
+	}
+	for x := l.head.Next[0]; x != nil; x = x.Next[0] {
+		n := &node[T]{Value: x.Value, Next: make([]*node[T], len(x.Next))}
+		if last[0] != c.head {
+			n.Prev = last[0]
+		}
+		for i := range n.Next {
+			last[i].Next[i] = n
+			last[i] = n
+		}
+		c.tail = n
+	}
+	return c
+}
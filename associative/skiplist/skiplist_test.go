@@ -0,0 +1,37 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/copiable"
+)
+
+func TestIteratorNextOnEnd(t *testing.T) {
+	l := NewSkipList(comparator.DefaultComparator[int](), 1)
+
+	it := l.End()
+	it.Next()
+	assert.True(t, it.Equals(l.End()))
+	it.Prev()
+	assert.Equal(t, 1, it.Value())
+}
+
+func TestCopyDoesNotShareSource(t *testing.T) {
+	l := NewSkipListWithSource(comparator.DefaultComparator[int](), rand.NewPCG(1, 2), 1, 2, 3)
+
+	c := copiable.Copy[*SkipList[int]](l)
+	assert.NotSame(t, l.source, c.source)
+
+	source := rand.NewPCG(3, 4)
+	c = l.CopyWithSource(source)
+	assert.Same(t, source, c.source)
+	for i := 4; i < 100; i++ {
+		c.Insert(i)
+	}
+	assert.Equal(t, uint(3), l.Size())
+	assert.Equal(t, uint(99), c.Size())
+}
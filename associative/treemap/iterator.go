@@ -0,0 +1,81 @@
+package treemap
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// iterator представляет двунаправленный итератор по элементам TreeMap в порядке возрастания ключей.
+// Конечный итератор указывает на nil узел, из него можно вернуться к последнему элементу методом Prev.
+type iterator[K any, V any] struct {
+	m       *TreeMap[K, V]
+	current *node[K, V]
+}
+
+// newIterator создаёт новый итератор, указывающий на узел n.
+func newIterator[K any, V any](m *TreeMap[K, V], n *node[K, V]) *iterator[K, V] {
+	return &iterator[K, V]{
+		m:       m,
+		current: n,
+	}
+}
+
+// HasNext проверяет, есть ли ещё элементы для обхода.
+func (it *iterator[K, V]) HasNext() bool {
+	return it.current != nil
+}
+
+// Next перемещает итератор к следующему элементу.
+func (it *iterator[K, V]) Next() {
+	if it.current != nil {
+		it.current = it.current.next()
+	}
+}
+
+// HasPrev проверяет, есть ли предыдущий элемент.
+func (it *iterator[K, V]) HasPrev() bool {
+	if it.current == nil {
+		return it.m.root != nil
+	}
+	return it.current.prev() != nil
+}
+
+// Prev перемещает итератор к предыдущему элементу.
+// Из конечного итератора переходит к последнему элементу отображения.
+func (it *iterator[K, V]) Prev() {
+	switch {
+	case it.current != nil:
+		it.current = it.current.prev()
+	case it.m.root != nil:
+		it.current = it.m.root.max()
+	}
+}
+
+// Value возвращает текущее значение узла.
+func (it *iterator[K, V]) Value() pair.Pair[K, V] {
+	return it.current.Entry
+}
+
+// Ptr возвращает указатель на текущее значение узла.
+// Изменение ключа через указатель нарушает упорядоченность отображения.
+func (it *iterator[K, V]) Ptr() *pair.Pair[K, V] {
+	return &it.current.Entry
+}
+
+// Equals сравнивает два итератора на равенство.
+func (it *iterator[K, V]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[K, V]:
+		return it.current == a.current
+	case *iterators.EndIterator:
+		return it.current == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *iterator[K, V]) Copy() copiable.Copiable {
+	return newIterator(it.m, it.current)
+}
@@ -0,0 +1,460 @@
+package treemap
+
+import (
+	"github.com/Delisa-sama/collections/comparator"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// color представляет цвет узла красно-черного дерева.
+type color uint8
+
+const (
+	red color = iota
+	black
+)
+
+// node представляет узел красно-черного дерева.
+type node[K any, V any] struct {
+	Entry  pair.Pair[K, V]
+	Color  color
+	Parent *node[K, V]
+	Left   *node[K, V]
+	Right  *node[K, V]
+}
+
+// next возвращает следующий узел в in-order порядке.
+func (n *node[K, V]) next() *node[K, V] {
+	if n.Right != nil {
+		return n.Right.min()
+	}
+	current := n
+	parent := n.Parent
+	for parent != nil && current == parent.Right {
+		current = parent
+		parent = parent.Parent
+	}
+	return parent
+}
+
+// prev возвращает предыдущий узел в in-order порядке.
+func (n *node[K, V]) prev() *node[K, V] {
+	if n.Left != nil {
+		return n.Left.max()
+	}
+	current := n
+	parent := n.Parent
+	for parent != nil && current == parent.Left {
+		current = parent
+		parent = parent.Parent
+	}
+	return parent
+}
+
+// min возвращает минимальный узел поддерева.
+func (n *node[K, V]) min() *node[K, V] {
+	for n.Left != nil {
+		n = n.Left
+	}
+	return n
+}
+
+// max возвращает максимальный узел поддерева.
+func (n *node[K, V]) max() *node[K, V] {
+	for n.Right != nil {
+		n = n.Right
+	}
+	return n
+}
+
+// isBlack проверяет что узел черный. Отсутствующие узлы (листья) считаются черными.
+func isBlack[K any, V any](n *node[K, V]) bool {
+	return n == nil || n.Color == black
+}
+
+// TreeMap представляет упорядоченное отображение ключей в значения на основе красно-черного дерева.
+// По сравнению с AVL деревом выполняет меньше вращений при вставке и удалении.
+type TreeMap[K any, V any] struct {
+	root *node[K, V]
+	size uint
+	comp comparator.Comparator[K]
+}
+
+// NewTreeMap создает новое отображение с заданным компаратором ключей и заполняет его переданными парами.
+func NewTreeMap[K any, V any](comp comparator.Comparator[K], items ...pair.Pair[K, V]) *TreeMap[K, V] {
+	m := &TreeMap[K, V]{comp: comp}
+	for i := range items {
+		m.Put(items[i].First, items[i].Second)
+	}
+	return m
+}
+
+// Size возвращает количество элементов в отображении.
+func (m *TreeMap[K, V]) Size() uint {
+	return m.size
+}
+
+// Len возвращает количество элементов в отображении, аналогично Size.
+func (m *TreeMap[K, V]) Len() uint {
+	return m.size
+}
+
+// IsEmpty проверяет что отображение пустое.
+func (m *TreeMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Clear удаляет все элементы из отображения.
+func (m *TreeMap[K, V]) Clear() {
+	m.root = nil
+	m.size = 0
+}
+
+// Get возвращает значение по ключу и признак его наличия.
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	n := m.find(key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	return n.Entry.Second, true
+}
+
+// Contains проверяет есть ли ключ в отображении.
+func (m *TreeMap[K, V]) Contains(key K) bool {
+	return m.find(key) != nil
+}
+
+func (m *TreeMap[K, V]) find(key K) *node[K, V] {
+	n := m.root
+	for n != nil {
+		c := m.comp(key, n.Entry.First)
+		switch {
+		case c == 0:
+			return n
+		case c < 0:
+			n = n.Left
+		default:
+			n = n.Right
+		}
+	}
+	return nil
+}
+
+// Put добавляет пару ключ-значение в отображение. Если ключ уже существует, его значение заменяется.
+func (m *TreeMap[K, V]) Put(key K, value V) {
+	var parent *node[K, V]
+	var c int
+	for n := m.root; n != nil; {
+		parent = n
+		c = m.comp(key, n.Entry.First)
+		switch {
+		case c == 0:
+			n.Entry.Second = value
+			return
+		case c < 0:
+			n = n.Left
+		default:
+			n = n.Right
+		}
+	}
+
+	z := &node[K, V]{
+		Entry:  pair.NewPair(key, value),
+		Color:  red,
+		Parent: parent,
+	}
+	switch {
+	case parent == nil:
+		m.root = z
+	case c < 0:
+		parent.Left = z
+	default:
+		parent.Right = z
+	}
+	m.size++
+	m.fixAfterInsert(z)
+}
+
+// fixAfterInsert восстанавливает свойства красно-черного дерева после вставки красного узла z.
+//
+//nolint:cyclop // допускается что внутренняя реализация контейнера может быть сложной ради оптимизации
+func (m *TreeMap[K, V]) fixAfterInsert(z *node[K, V]) {
+	for z != m.root && z.Parent.Color == red {
+		p := z.Parent
+		g := p.Parent
+		if p == g.Left {
+			u := g.Right
+			if !isBlack(u) {
+				p.Color, u.Color, g.Color = black, black, red
+				z = g
+				continue
+			}
+			if z == p.Right {
+				z = p
+				m.rotateLeft(z)
+				p = z.Parent
+			}
+			p.Color, g.Color = black, red
+			m.rotateRight(g)
+		} else {
+			u := g.Left
+			if !isBlack(u) {
+				p.Color, u.Color, g.Color = black, black, red
+				z = g
+				continue
+			}
+			if z == p.Left {
+				z = p
+				m.rotateRight(z)
+				p = z.Parent
+			}
+			p.Color, g.Color = black, red
+			m.rotateLeft(g)
+		}
+	}
+	m.root.Color = black
+}
+
+// Delete удаляет ключ из отображения.
+// Возвращает true в случае успешного удаления.
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	z := m.find(key)
+	if z == nil {
+		return false
+	}
+	m.delete(z)
+	m.size--
+	return true
+}
+
+// delete удаляет узел z из дерева. Узлы не копируются, поэтому итераторы
+// на остальные элементы остаются валидными.
+func (m *TreeMap[K, V]) delete(z *node[K, V]) {
+	// x - узел, занимающий место удаляемого, xParent - его родитель (x может быть nil).
+	var x, xParent *node[K, V]
+	removedColor := z.Color
+
+	switch {
+	case z.Left == nil:
+		x, xParent = z.Right, z.Parent
+		m.transplant(z, z.Right)
+	case z.Right == nil:
+		x, xParent = z.Left, z.Parent
+		m.transplant(z, z.Left)
+	default:
+		y := z.Right.min()
+		removedColor = y.Color
+		x = y.Right
+		if y.Parent == z {
+			xParent = y
+		} else {
+			xParent = y.Parent
+			m.transplant(y, y.Right)
+			y.Right = z.Right
+			y.Right.Parent = y
+		}
+		m.transplant(z, y)
+		y.Left = z.Left
+		y.Left.Parent = y
+		y.Color = z.Color
+	}
+
+	if removedColor == black {
+		m.fixAfterDelete(x, xParent)
+	}
+}
+
+// transplant заменяет поддерево u поддеревом v.
+func (m *TreeMap[K, V]) transplant(u, v *node[K, V]) {
+	switch {
+	case u.Parent == nil:
+		m.root = v
+	case u == u.Parent.Left:
+		u.Parent.Left = v
+	default:
+		u.Parent.Right = v
+	}
+	if v != nil {
+		v.Parent = u.Parent
+	}
+}
+
+// fixAfterDelete восстанавливает свойства красно-черного дерева после удаления черного узла.
+//
+//nolint:cyclop,gocognit // допускается что внутренняя реализация контейнера может быть сложной ради оптимизации
+func (m *TreeMap[K, V]) fixAfterDelete(x, parent *node[K, V]) {
+	for x != m.root && isBlack(x) {
+		if x == parent.Left {
+			w := parent.Right
+			if !isBlack(w) {
+				w.Color, parent.Color = black, red
+				m.rotateLeft(parent)
+				w = parent.Right
+			}
+			if isBlack(w.Left) && isBlack(w.Right) {
+				w.Color = red
+				x, parent = parent, parent.Parent
+				continue
+			}
+			if isBlack(w.Right) {
+				w.Left.Color, w.Color = black, red
+				m.rotateRight(w)
+				w = parent.Right
+			}
+			w.Color, parent.Color = parent.Color, black
+			w.Right.Color = black
+			m.rotateLeft(parent)
+		} else {
+			w := parent.Left
+			if !isBlack(w) {
+				w.Color, parent.Color = black, red
+				m.rotateRight(parent)
+				w = parent.Left
+			}
+			if isBlack(w.Left) && isBlack(w.Right) {
+				w.Color = red
+				x, parent = parent, parent.Parent
+				continue
+			}
+			if isBlack(w.Left) {
+				w.Right.Color, w.Color = black, red
+				m.rotateLeft(w)
+				w = parent.Left
+			}
+			w.Color, parent.Color = parent.Color, black
+			w.Left.Color = black
+			m.rotateRight(parent)
+		}
+		x = m.root
+	}
+	if x != nil {
+		x.Color = black
+	}
+}
+
+// rotateLeft выполняет левое вращение вокруг узла x.
+func (m *TreeMap[K, V]) rotateLeft(x *node[K, V]) {
+	y := x.Right
+	x.Right = y.Left
+	if y.Left != nil {
+		y.Left.Parent = x
+	}
+	m.transplant(x, y)
+	y.Left = x
+	x.Parent = y
+}
+
+// rotateRight выполняет правое вращение вокруг узла x.
+func (m *TreeMap[K, V]) rotateRight(x *node[K, V]) {
+	y := x.Left
+	x.Left = y.Right
+	if y.Right != nil {
+		y.Right.Parent = x
+	}
+	m.transplant(x, y)
+	y.Right = x
+	x.Parent = y
+}
+
+// Find возвращает итератор на элемент с заданным ключом.
+// Если ключ не найден, возвращает конечный итератор.
+func (m *TreeMap[K, V]) Find(key K) interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return newIterator(m, m.find(key))
+}
+
+// LowerBound возвращает итератор на первый элемент, ключ которого не меньше key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (m *TreeMap[K, V]) LowerBound(key K) interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	var result *node[K, V]
+	for n := m.root; n != nil; {
+		if m.comp(n.Entry.First, key) >= 0 {
+			result = n
+			n = n.Left
+		} else {
+			n = n.Right
+		}
+	}
+	return newIterator(m, result)
+}
+
+// UpperBound возвращает итератор на первый элемент, ключ которого больше key.
+// Если такого элемента нет, возвращает конечный итератор.
+func (m *TreeMap[K, V]) UpperBound(key K) interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	var result *node[K, V]
+	for n := m.root; n != nil; {
+		if m.comp(n.Entry.First, key) > 0 {
+			result = n
+			n = n.Left
+		} else {
+			n = n.Right
+		}
+	}
+	return newIterator(m, result)
+}
+
+// Range возвращает пару итераторов [begin, end) на элементы, ключи которых лежат в диапазоне [from, to).
+func (m *TreeMap[K, V]) Range(from, to K) (
+	interfaces.BidirectionalIterator[pair.Pair[K, V]],
+	interfaces.BidirectionalIterator[pair.Pair[K, V]],
+) {
+	begin := m.LowerBound(from)
+	if m.comp(from, to) >= 0 {
+		return begin, copiable.Copy[interfaces.BidirectionalIterator[pair.Pair[K, V]]](begin)
+	}
+	return begin, m.LowerBound(to)
+}
+
+// Begin возвращает итератор на элемент с минимальным ключом.
+func (m *TreeMap[K, V]) Begin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	if m.root == nil {
+		return newIterator[K, V](m, nil)
+	}
+	return newIterator(m, m.root.min())
+}
+
+// End возвращает итератор на элемент после последнего.
+// Итератор можно сдвинуть назад методом Prev к последнему элементу.
+func (m *TreeMap[K, V]) End() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return newIterator[K, V](m, nil)
+}
+
+// RBegin возвращает перевернутый итератор на элемент с максимальным ключом.
+func (m *TreeMap[K, V]) RBegin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	if m.root == nil {
+		return iterators.NewReverseIterator[pair.Pair[K, V]](newIterator[K, V](m, nil))
+	}
+	return iterators.NewReverseIterator[pair.Pair[K, V]](newIterator(m, m.root.max()))
+}
+
+// REnd возвращает итератор на конец перевернутого отображения.
+func (m *TreeMap[K, V]) REnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию отображения.
+// Копирование сохраняет структуру дерева и не требует повторной балансировки.
+func (m *TreeMap[K, V]) Copy() copiable.Copiable {
+	return &TreeMap[K, V]{
+		root: cloneNode(m.root, nil),
+		size: m.size,
+		comp: m.comp,
+	}
+}
+
+// cloneNode рекурсивно копирует поддерево n, проставляя копии родителя parent.
+func cloneNode[K any, V any](n *node[K, V], parent *node[K, V]) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	c := &node[K, V]{
+		Entry:  n.Entry,
+		Color:  n.Color,
+		Parent: parent,
+	}
+	c.Left = cloneNode(n.Left, c)
+	c.Right = cloneNode(n.Right, c)
+	return c
+}
@@ -0,0 +1,137 @@
+package treemap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Delisa-sama/collections/comparator"
+)
+
+// checkInvariants проверяет свойства красно-черного дерева: черный корень, отсутствие красных узлов
+// с красными детьми, одинаковую черную высоту всех путей, связи с родителями и упорядоченность ключей.
+func checkInvariants(t *testing.T, m *TreeMap[int, int]) {
+	t.Helper()
+	if m.root == nil {
+		require.Zero(t, m.size)
+		return
+	}
+	require.Nil(t, m.root.Parent)
+	require.Equal(t, black, m.root.Color)
+
+	var count uint
+	var walk func(n *node[int, int], lo, hi *int) int
+	walk = func(n *node[int, int], lo, hi *int) int {
+		if n == nil {
+			return 1
+		}
+		count++
+		if lo != nil {
+			require.Less(t, *lo, n.Entry.First)
+		}
+		if hi != nil {
+			require.Less(t, n.Entry.First, *hi)
+		}
+		if n.Color == red {
+			require.True(t, isBlack(n.Left), "red node %d has red left child", n.Entry.First)
+			require.True(t, isBlack(n.Right), "red node %d has red right child", n.Entry.First)
+		}
+		if n.Left != nil {
+			require.Same(t, n, n.Left.Parent)
+		}
+		if n.Right != nil {
+			require.Same(t, n, n.Right.Parent)
+		}
+		left := walk(n.Left, lo, &n.Entry.First)
+		right := walk(n.Right, &n.Entry.First, hi)
+		require.Equal(t, left, right, "black height differs under %d", n.Entry.First)
+		if n.Color == black {
+			left++
+		}
+		return left
+	}
+	walk(m.root, nil, nil)
+	require.Equal(t, m.size, count)
+}
+
+// checkModel сравнивает содержимое отображения с отображением-моделью в прямом и обратном порядке.
+func checkModel(t *testing.T, m *TreeMap[int, int], model map[int]int) {
+	t.Helper()
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	require.Equal(t, uint(len(model)), m.Len())
+	i := 0
+	for it := m.Begin(); !it.Equals(m.End()); it.Next() {
+		require.Equal(t, keys[i], it.Value().First)
+		require.Equal(t, model[keys[i]], it.Value().Second)
+		i++
+	}
+	require.Equal(t, len(keys), i)
+	for it := m.RBegin(); !it.Equals(m.REnd()); it.Next() {
+		i--
+		require.Equal(t, keys[i], it.Value().First)
+	}
+	require.Zero(t, i)
+}
+
+func TestRandomPutDelete(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	m := NewTreeMap[int, int](comparator.DefaultComparator[int]())
+	model := make(map[int]int)
+
+	for step := 0; step < 5000; step++ {
+		key := rnd.Intn(500)
+		// Сначала дерево в основном растет, затем в основном сокращается.
+		if rnd.Intn(5000) < 5000-step {
+			value := rnd.Int()
+			m.Put(key, value)
+			model[key] = value
+		} else {
+			_, ok := model[key]
+			assert.Equal(t, ok, m.Delete(key))
+			delete(model, key)
+		}
+
+		value, ok := m.Get(key)
+		expected, expectedOk := model[key]
+		require.Equal(t, expectedOk, ok)
+		require.Equal(t, expected, value)
+		require.Equal(t, expectedOk, m.Contains(key))
+
+		if step%10 == 0 {
+			checkInvariants(t, m)
+			checkModel(t, m, model)
+		}
+	}
+	for key := range model {
+		require.True(t, m.Delete(key))
+		delete(model, key)
+		checkInvariants(t, m)
+	}
+	checkModel(t, m, model)
+	assert.True(t, m.IsEmpty())
+}
+
+func TestSequentialPutDelete(t *testing.T) {
+	m := NewTreeMap[int, int](comparator.DefaultComparator[int]())
+	for i := 0; i < 1000; i++ {
+		m.Put(i, i)
+	}
+	checkInvariants(t, m)
+	for i := 0; i < 1000; i += 2 {
+		require.True(t, m.Delete(i))
+	}
+	checkInvariants(t, m)
+	for i := 999; i >= 0; i -= 2 {
+		require.True(t, m.Delete(i))
+		checkInvariants(t, m)
+	}
+	assert.True(t, m.IsEmpty())
+}
@@ -0,0 +1,81 @@
+package trie
+
+import (
+	"github.com/Delisa-sama/collections/adapters/stack"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+// iterator представляет собой прямой итератор по элементам поддерева в лексикографическом порядке ключей.
+// Узлы обходятся в pre-order порядке, потомки - по возрастанию первого байта метки.
+type iterator[K Key, V any] struct {
+	current *node[K, V]
+	s       *stack.Stack[*node[K, V], *vector.Vector[*node[K, V]]]
+}
+
+// newIterator создает итератор по элементам поддерева с корнем root.
+// Если root равен nil, итератор сразу указывает на конец.
+func newIterator[K Key, V any](root *node[K, V]) *iterator[K, V] {
+	it := &iterator[K, V]{
+		s: stack.NewStack(vector.NewVector[*node[K, V]]),
+	}
+	if root != nil {
+		it.s.Push(root)
+	}
+	it.Next()
+	return it
+}
+
+// HasNext проверяет, есть ли ещё элементы для обхода.
+func (it *iterator[K, V]) HasNext() bool {
+	return it.current != nil
+}
+
+// Next переходит к следующему элементу, пропуская промежуточные узлы без значений.
+func (it *iterator[K, V]) Next() {
+	for !it.s.IsEmpty() {
+		n := it.s.Top()
+		it.s.Pop()
+		for i := len(n.Children) - 1; i >= 0; i-- {
+			it.s.Push(n.Children[i])
+		}
+		if n.HasValue {
+			it.current = n
+			return
+		}
+	}
+	it.current = nil
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator[K, V]) Value() pair.Pair[K, V] {
+	return it.current.Entry
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+// Изменение ключа через указатель нарушает целостность дерева.
+func (it *iterator[K, V]) Ptr() *pair.Pair[K, V] {
+	return &it.current.Entry
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *iterator[K, V]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[K, V]:
+		return it.current == a.current
+	case *iterators.EndIterator:
+		return it.current == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *iterator[K, V]) Copy() copiable.Copiable {
+	return &iterator[K, V]{
+		current: it.current,
+		s:       it.s.Copy(),
+	}
+}
@@ -0,0 +1,295 @@
+package trie
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// Key объединяет под собой типы ключей, поддерживаемые префиксным деревом.
+type Key interface {
+	~string | ~[]byte
+}
+
+// node представляет узел сжатого префиксного дерева.
+type node[K Key, V any] struct {
+	Prefix   string          // Метка ребра, ведущего в узел.
+	Children []*node[K, V]   // Потомки, упорядоченные по первому байту метки.
+	Entry    pair.Pair[K, V] // Ключ и значение, если узел хранит элемент.
+	HasValue bool
+}
+
+// child возвращает индекс потомка, метка которого начинается с байта b, и сам потомок.
+// Если потомок не найден, возвращает индекс, по которому его следует вставить, и nil.
+func (n *node[K, V]) child(b byte) (int, *node[K, V]) {
+	idx := sort.Search(len(n.Children), func(i int) bool {
+		return n.Children[i].Prefix[0] >= b
+	})
+	if idx < len(n.Children) && n.Children[idx].Prefix[0] == b {
+		return idx, n.Children[idx]
+	}
+	return idx, nil
+}
+
+// insertChild вставляет потомка в позицию idx.
+func (n *node[K, V]) insertChild(idx int, c *node[K, V]) {
+	n.Children = append(n.Children, nil)
+	copy(n.Children[idx+1:], n.Children[idx:])
+	n.Children[idx] = c
+}
+
+// clone рекурсивно копирует поддерево.
+func (n *node[K, V]) clone() *node[K, V] {
+	c := &node[K, V]{
+		Prefix:   n.Prefix,
+		Entry:    n.Entry,
+		HasValue: n.HasValue,
+	}
+	if n.HasValue {
+		c.Entry.First = K(string(n.Entry.First))
+	}
+	if len(n.Children) > 0 {
+		c.Children = make([]*node[K, V], len(n.Children))
+		for i := range n.Children {
+			c.Children[i] = n.Children[i].clone()
+		}
+	}
+	return c
+}
+
+// commonPrefixLen возвращает длину общего префикса двух строк.
+func commonPrefixLen(a, b string) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
+
+// Trie представляет собой сжатое префиксное (radix) дерево для строковых ключей и ключей-последовательностей байт.
+// Элементы перебираются в лексикографическом порядке ключей.
+type Trie[K Key, V any] struct {
+	root *node[K, V]
+	size uint
+}
+
+// NewTrie создает новое префиксное дерево и заполняет его переданными парами.
+func NewTrie[K Key, V any](items ...pair.Pair[K, V]) *Trie[K, V] {
+	t := &Trie[K, V]{root: &node[K, V]{}}
+	for i := range items {
+		t.Insert(items[i].First, items[i].Second)
+	}
+	return t
+}
+
+// Size возвращает количество элементов в дереве.
+func (t *Trie[K, V]) Size() uint {
+	return t.size
+}
+
+// IsEmpty проверяет что дерево пустое.
+func (t *Trie[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear удаляет все элементы из дерева.
+func (t *Trie[K, V]) Clear() {
+	t.root = &node[K, V]{}
+	t.size = 0
+}
+
+// Insert вставляет пару ключ-значение в дерево. Если ключ уже существует, его значение заменяется.
+func (t *Trie[K, V]) Insert(key K, value V) {
+	entry := pair.NewPair(K(string(key)), value)
+	n := t.root
+	rest := string(key)
+	for rest != "" {
+		idx, c := n.child(rest[0])
+		if c == nil {
+			n.insertChild(idx, &node[K, V]{Prefix: rest, Entry: entry, HasValue: true})
+			t.size++
+			return
+		}
+
+		common := commonPrefixLen(rest, c.Prefix)
+		if common == len(c.Prefix) {
+			n = c
+			rest = rest[common:]
+			continue
+		}
+
+		// Ключ расходится с меткой ребра: разделяем ребро промежуточным узлом.
+		mid := &node[K, V]{Prefix: c.Prefix[:common], Children: []*node[K, V]{c}}
+		c.Prefix = c.Prefix[common:]
+		n.Children[idx] = mid
+		if common == len(rest) {
+			mid.Entry, mid.HasValue = entry, true
+		} else {
+			leaf := &node[K, V]{Prefix: rest[common:], Entry: entry, HasValue: true}
+			leafIdx, _ := mid.child(leaf.Prefix[0])
+			mid.insertChild(leafIdx, leaf)
+		}
+		t.size++
+		return
+	}
+
+	if !n.HasValue {
+		t.size++
+	}
+	n.Entry, n.HasValue = entry, true
+}
+
+// find возвращает узел, путь к которому совпадает с key, или nil.
+func (t *Trie[K, V]) find(key string) *node[K, V] {
+	n := t.root
+	for key != "" {
+		_, c := n.child(key[0])
+		if c == nil || !strings.HasPrefix(key, c.Prefix) {
+			return nil
+		}
+		n = c
+		key = key[len(c.Prefix):]
+	}
+	return n
+}
+
+// Get возвращает значение по ключу и признак его наличия.
+func (t *Trie[K, V]) Get(key K) (V, bool) {
+	n := t.find(string(key))
+	if n == nil || !n.HasValue {
+		var zero V
+		return zero, false
+	}
+	return n.Entry.Second, true
+}
+
+// Contains проверяет есть ли ключ в дереве.
+func (t *Trie[K, V]) Contains(key K) bool {
+	n := t.find(string(key))
+	return n != nil && n.HasValue
+}
+
+// LongestPrefixMatch находит в дереве самый длинный ключ, являющийся префиксом key.
+// Возвращает найденный ключ, его значение и признак успеха.
+func (t *Trie[K, V]) LongestPrefixMatch(key K) (K, V, bool) {
+	var match *node[K, V]
+	n := t.root
+	rest := string(key)
+	for {
+		if n.HasValue {
+			match = n
+		}
+		if rest == "" {
+			break
+		}
+		_, c := n.child(rest[0])
+		if c == nil || !strings.HasPrefix(rest, c.Prefix) {
+			break
+		}
+		n = c
+		rest = rest[len(c.Prefix):]
+	}
+
+	if match == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return match.Entry.First, match.Entry.Second, true
+}
+
+// Delete удаляет ключ из дерева.
+// Возвращает true в случае успешного удаления.
+func (t *Trie[K, V]) Delete(key K) bool {
+	if !t.delete(t.root, string(key)) {
+		return false
+	}
+	t.size--
+	return true
+}
+
+// delete удаляет ключ rest из поддерева n и сжимает опустевшие узлы на обратном пути.
+func (t *Trie[K, V]) delete(n *node[K, V], rest string) bool {
+	if rest == "" {
+		if !n.HasValue {
+			return false
+		}
+		n.Entry, n.HasValue = pair.Pair[K, V]{}, false
+		return true
+	}
+
+	idx, c := n.child(rest[0])
+	if c == nil || !strings.HasPrefix(rest, c.Prefix) {
+		return false
+	}
+	if !t.delete(c, rest[len(c.Prefix):]) {
+		return false
+	}
+
+	if !c.HasValue {
+		switch len(c.Children) {
+		case 0:
+			// Удаляем пустой лист.
+			n.Children = append(n.Children[:idx], n.Children[idx+1:]...)
+		case 1:
+			// Сливаем промежуточный узел с его единственным потомком.
+			grandchild := c.Children[0]
+			grandchild.Prefix = c.Prefix + grandchild.Prefix
+			n.Children[idx] = grandchild
+		}
+	}
+	return true
+}
+
+// Begin возвращает итератор на первый в лексикографическом порядке элемент дерева.
+func (t *Trie[K, V]) Begin() interfaces.ForwardIterator[pair.Pair[K, V]] {
+	return newIterator(t.root)
+}
+
+// End возвращает итератор на конец дерева.
+func (t *Trie[K, V]) End() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// PrefixBegin возвращает итератор по элементам, ключи которых начинаются с prefix,
+// в лексикографическом порядке ключей.
+func (t *Trie[K, V]) PrefixBegin(prefix K) interfaces.ForwardIterator[pair.Pair[K, V]] {
+	n := t.root
+	rest := string(prefix)
+	for rest != "" {
+		_, c := n.child(rest[0])
+		if c == nil {
+			return newIterator[K, V](nil)
+		}
+		common := commonPrefixLen(rest, c.Prefix)
+		if common == len(rest) {
+			// Префикс заканчивается внутри метки ребра: все ключи поддерева c подходят.
+			return newIterator(c)
+		}
+		if common < len(c.Prefix) {
+			return newIterator[K, V](nil)
+		}
+		n = c
+		rest = rest[common:]
+	}
+	return newIterator(n)
+}
+
+// PrefixEnd возвращает конечный итератор для обхода элементов по префиксу.
+func (t *Trie[K, V]) PrefixEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию дерева.
+func (t *Trie[K, V]) Copy() copiable.Copiable {
+	return &Trie[K, V]{
+		root: t.root.clone(),
+		size: t.size,
+	}
+}
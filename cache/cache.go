@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/sequence/list"
+)
+
+// EvictCallback вызывается для каждого элемента, вытесненного из кеша при превышении емкости.
+type EvictCallback[K any, V any] func(key K, value V)
+
+// Stats представляет собой статистику обращений к кешу.
+type Stats struct {
+	Hits      uint64 // Количество вызовов Get, нашедших ключ.
+	Misses    uint64 // Количество вызовов Get, не нашедших ключ.
+	Evictions uint64 // Количество элементов, вытесненных при превышении емкости.
+}
+
+// HitRate возвращает долю успешных обращений среди всех вызовов Get.
+// Если обращений не было, возвращает 0.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// erase удаляет из списка элемент, на который указывает it.
+func erase[T any](l *list.List[T], it interfaces.BidirectionalIterator[T]) {
+	// Erase сдвигает переданный итератор, поэтому передаем копию.
+	begin := copiable.Copy[interfaces.BidirectionalIterator[T]](it)
+	next := copiable.Copy[interfaces.BidirectionalIterator[T]](it)
+	next.Next()
+	l.Erase(begin, next)
+}
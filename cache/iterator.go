@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// lfuIterator представляет собой прямой итератор по элементам LFU кеша.
+// Последовательно обходит группы элементов по убыванию частоты обращений и элементы внутри каждой группы.
+type lfuIterator[K any, V any] struct {
+	bucket     interfaces.BidirectionalIterator[*frequencyBucket[K, V]]
+	bucketsEnd interfaces.Iterator
+	entry      interfaces.BidirectionalIterator[*lfuItem[K, V]] // nil, если итератор указывает на конец.
+}
+
+// newLFUIterator создает итератор, указывающий на первый элемент группы bucket.
+func newLFUIterator[K any, V any](
+	bucket interfaces.BidirectionalIterator[*frequencyBucket[K, V]],
+	bucketsEnd interfaces.Iterator,
+) *lfuIterator[K, V] {
+	it := &lfuIterator[K, V]{
+		bucket:     bucket,
+		bucketsEnd: bucketsEnd,
+	}
+	if !bucket.Equals(bucketsEnd) {
+		it.entry = bucket.Value().entries.Begin()
+	}
+	return it
+}
+
+// HasNext проверяет, указывает ли итератор на элемент кеша.
+func (it *lfuIterator[K, V]) HasNext() bool {
+	return it.entry != nil
+}
+
+// Next переходит к следующему элементу.
+func (it *lfuIterator[K, V]) Next() {
+	it.entry.Next()
+	if !it.entry.Equals(it.bucket.Value().entries.End()) {
+		return
+	}
+	// Группы не бывают пустыми, поэтому следующая группа содержит хотя бы один элемент.
+	it.bucket.Next()
+	if it.bucket.Equals(it.bucketsEnd) {
+		it.entry = nil
+		return
+	}
+	it.entry = it.bucket.Value().entries.Begin()
+}
+
+// Value возвращает текущее значение итератора.
+func (it *lfuIterator[K, V]) Value() pair.Pair[K, V] {
+	return it.entry.Value().recent.Value()
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+func (it *lfuIterator[K, V]) Ptr() *pair.Pair[K, V] {
+	return it.entry.Value().recent.Ptr()
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *lfuIterator[K, V]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *lfuIterator[K, V]:
+		if it.entry == nil || a.entry == nil {
+			return it.entry == nil && a.entry == nil
+		}
+		return it.entry.Equals(a.entry)
+	case *iterators.EndIterator:
+		return it.entry == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *lfuIterator[K, V]) Copy() copiable.Copiable {
+	c := &lfuIterator[K, V]{
+		bucket:     copiable.Copy[interfaces.BidirectionalIterator[*frequencyBucket[K, V]]](it.bucket),
+		bucketsEnd: it.bucketsEnd,
+	}
+	if it.entry != nil {
+		c.entry = copiable.Copy[interfaces.BidirectionalIterator[*lfuItem[K, V]]](it.entry)
+	}
+	return c
+}
@@ -0,0 +1,282 @@
+package cache
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/pair"
+	"github.com/Delisa-sama/collections/sequence/list"
+)
+
+// frequencyBucket представляет собой группу элементов LFU кеша с одинаковой частотой обращений.
+// Элементы группы хранятся от недавно использованных к давно использованным.
+type frequencyBucket[K any, V any] struct {
+	frequency uint64
+	entries   *list.List[*lfuItem[K, V]]
+	position  interfaces.BidirectionalIterator[*frequencyBucket[K, V]] // Позиция группы в списке групп.
+}
+
+// lfuItem указывает расположение элемента LFU кеша.
+type lfuItem[K any, V any] struct {
+	bucket   *frequencyBucket[K, V]
+	position interfaces.BidirectionalIterator[*lfuItem[K, V]]  // Позиция элемента в списке группы.
+	recent   interfaces.BidirectionalIterator[pair.Pair[K, V]] // Позиция элемента в списке использования.
+}
+
+// LFU представляет собой кеш ограниченной емкости с вытеснением наименее часто использованных элементов.
+// Среди элементов с равной частотой обращений вытесняется давно не использованный.
+// Все операции выполняются за O(1).
+type LFU[K comparable, V any] struct {
+	capacity uint
+	items    *list.List[pair.Pair[K, V]]        // Элементы от недавно использованных к давно использованным.
+	buckets  *list.List[*frequencyBucket[K, V]] // Группы в порядке убывания частоты обращений.
+	index    map[K]*lfuItem[K, V]
+	onEvict  EvictCallback[K, V]
+	stats    Stats
+}
+
+// NewLFU создает новый LFU кеш заданной емкости.
+// onEvict вызывается для каждого вытесненного элемента и может быть nil.
+// Если емкость равна нулю, возникает паника.
+func NewLFU[K comparable, V any](capacity uint, onEvict EvictCallback[K, V]) *LFU[K, V] {
+	if capacity == 0 {
+		panic("cache capacity must be positive")
+	}
+	return &LFU[K, V]{
+		capacity: capacity,
+		items:    list.NewList[pair.Pair[K, V]](),
+		buckets:  list.NewList[*frequencyBucket[K, V]](),
+		index:    make(map[K]*lfuItem[K, V], capacity),
+		onEvict:  onEvict,
+	}
+}
+
+// Capacity возвращает емкость кеша.
+func (c *LFU[K, V]) Capacity() uint {
+	return c.capacity
+}
+
+// Size возвращает количество элементов в кеше.
+func (c *LFU[K, V]) Size() uint {
+	return c.items.Size()
+}
+
+// IsEmpty проверяет что кеш пустой.
+func (c *LFU[K, V]) IsEmpty() bool {
+	return c.items.IsEmpty()
+}
+
+// Stats возвращает статистику обращений к кешу.
+func (c *LFU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats обнуляет статистику обращений к кешу.
+func (c *LFU[K, V]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// Frequency возвращает количество обращений к ключу с момента его вставки и признак его наличия.
+func (c *LFU[K, V]) Frequency(key K) (uint64, bool) {
+	item, found := c.index[key]
+	if !found {
+		return 0, false
+	}
+	return item.bucket.frequency, true
+}
+
+// Get возвращает значение по ключу и признак его наличия.
+// Частота обращений к найденному элементу увеличивается. Обращение учитывается в статистике.
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	item, found := c.index[key]
+	if !found {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.touch(item)
+	return item.recent.Value().Second, true
+}
+
+// Peek возвращает значение по ключу и признак его наличия,
+// не изменяя частоту обращений и статистику.
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	item, found := c.index[key]
+	if !found {
+		var zero V
+		return zero, false
+	}
+	return item.recent.Value().Second, true
+}
+
+// Contains проверяет есть ли ключ в кеше, не изменяя частоту обращений и статистику.
+func (c *LFU[K, V]) Contains(key K) bool {
+	_, found := c.index[key]
+	return found
+}
+
+// Put добавляет пару ключ-значение в кеш или обновляет значение существующего ключа.
+// Обновление увеличивает частоту обращений к элементу, новый элемент получает частоту 1.
+// Если кеш заполнен, вытесняется наименее часто использованный элемент.
+// Возвращает true, если при вставке был вытеснен элемент.
+func (c *LFU[K, V]) Put(key K, value V) bool {
+	if item, found := c.index[key]; found {
+		item.recent.Ptr().Second = value
+		c.touch(item)
+		return false
+	}
+
+	evicted := false
+	if c.items.Size() == c.capacity {
+		c.evict()
+		evicted = true
+	}
+
+	// Группа с частотой 1, если она есть, всегда последняя.
+	var bucket *frequencyBucket[K, V]
+	if !c.buckets.IsEmpty() && c.buckets.Back().frequency == 1 {
+		bucket = c.buckets.Back()
+	} else {
+		bucket = c.newBucket(1, c.buckets.End())
+	}
+	c.items.PushFront(pair.NewPair(key, value))
+	item := &lfuItem[K, V]{bucket: bucket, recent: c.items.Begin()}
+	bucket.entries.PushFront(item)
+	item.position = bucket.entries.Begin()
+	c.index[key] = item
+	return evicted
+}
+
+// newBucket создает группу с заданной частотой и вставляет её в список групп перед pos.
+func (c *LFU[K, V]) newBucket(frequency uint64, pos interfaces.Iterator) *frequencyBucket[K, V] {
+	bucket := &frequencyBucket[K, V]{
+		frequency: frequency,
+		entries:   list.NewList[*lfuItem[K, V]](),
+	}
+	bucket.position = c.buckets.InsertBefore(pos, bucket)
+	return bucket
+}
+
+// touch переносит элемент в группу со следующей частотой обращений и делает его последним использованным.
+func (c *LFU[K, V]) touch(item *lfuItem[K, V]) {
+	current := item.bucket
+	frequency := current.frequency + 1
+
+	// Группы упорядочены по убыванию частоты, поэтому следующая частота может быть только у предыдущей группы.
+	var target *frequencyBucket[K, V]
+	if current.position.HasPrev() {
+		prev := copiable.Copy[interfaces.BidirectionalIterator[*frequencyBucket[K, V]]](current.position)
+		prev.Prev()
+		if prev.Value().frequency == frequency {
+			target = prev.Value()
+		}
+	}
+	if target == nil {
+		target = c.newBucket(frequency, current.position)
+	}
+
+	erase(current.entries, item.position)
+	target.entries.PushFront(item)
+	item.bucket = target
+	item.position = target.entries.Begin()
+	c.items.MoveToFront(item.recent)
+
+	if current.entries.IsEmpty() {
+		erase(c.buckets, current.position)
+	}
+}
+
+// evict вытесняет давно не использованный элемент из группы с наименьшей частотой обращений.
+func (c *LFU[K, V]) evict() {
+	victim := c.buckets.Back().entries.Back().recent.Value()
+	c.remove(victim.First)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(victim.First, victim.Second)
+	}
+}
+
+// remove удаляет элемент с ключом key, про который известно что он есть в кеше.
+func (c *LFU[K, V]) remove(key K) {
+	item := c.index[key]
+	erase(item.bucket.entries, item.position)
+	if item.bucket.entries.IsEmpty() {
+		erase(c.buckets, item.bucket.position)
+	}
+	erase(c.items, item.recent)
+	delete(c.index, key)
+}
+
+// Remove удаляет ключ из кеша. Функция вытеснения для удаленного элемента не вызывается.
+// Возвращает true в случае успешного удаления.
+func (c *LFU[K, V]) Remove(key K) bool {
+	if _, found := c.index[key]; !found {
+		return false
+	}
+	c.remove(key)
+	return true
+}
+
+// Resize изменяет емкость кеша, вытесняя наименее часто использованные элементы при необходимости.
+// Если емкость равна нулю, возникает паника.
+func (c *LFU[K, V]) Resize(capacity uint) {
+	if capacity == 0 {
+		panic("cache capacity must be positive")
+	}
+	c.capacity = capacity
+	for c.items.Size() > c.capacity {
+		c.evict()
+	}
+}
+
+// Clear удаляет все элементы из кеша без вызова функции вытеснения. Статистика сохраняется.
+func (c *LFU[K, V]) Clear() {
+	c.items = list.NewList[pair.Pair[K, V]]()
+	c.buckets = list.NewList[*frequencyBucket[K, V]]()
+	clear(c.index)
+}
+
+// Begin возвращает итератор на последний использованный элемент.
+// Элементы перебираются от недавно использованных к давно использованным.
+// Итерирование не изменяет частоту обращений. Изменение ключа через Ptr нарушает целостность кеша.
+func (c *LFU[K, V]) Begin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return c.items.Begin()
+}
+
+// End возвращает итератор на конец кеша.
+func (c *LFU[K, V]) End() interfaces.Iterator {
+	return c.items.End()
+}
+
+// FrequencyBegin возвращает итератор на первый элемент кеша в порядке, обратном порядку вытеснения:
+// по убыванию частоты обращений, а при равной частоте - от недавно использованных к давно использованным.
+// Итерирование не изменяет частоту обращений. Изменение ключа через Ptr нарушает целостность кеша.
+func (c *LFU[K, V]) FrequencyBegin() interfaces.ForwardIterator[pair.Pair[K, V]] {
+	return newLFUIterator(c.buckets.Begin(), c.buckets.End())
+}
+
+// FrequencyEnd возвращает итератор на конец кеша для перебора по частоте обращений.
+func (c *LFU[K, V]) FrequencyEnd() interfaces.Iterator {
+	return c.buckets.End()
+}
+
+// Copy возвращает копию кеша с теми же частотами и порядком использования элементов,
+// функцией вытеснения и статистикой.
+func (c *LFU[K, V]) Copy() copiable.Copiable {
+	cacheCopy := NewLFU[K, V](c.capacity, c.onEvict)
+	for it := c.items.RBegin(); !it.Equals(c.items.REnd()); it.Next() {
+		cacheCopy.items.PushFront(it.Value())
+		cacheCopy.index[it.Value().First] = &lfuItem[K, V]{recent: cacheCopy.items.Begin()}
+	}
+	for it := c.buckets.Begin(); !it.Equals(c.buckets.End()); it.Next() {
+		bucket := cacheCopy.newBucket(it.Value().frequency, cacheCopy.buckets.End())
+		entries := it.Value().entries
+		for e := entries.Begin(); !e.Equals(entries.End()); e.Next() {
+			item := cacheCopy.index[e.Value().recent.Value().First]
+			item.bucket = bucket
+			item.position = bucket.entries.InsertBefore(bucket.entries.End(), item)
+		}
+	}
+	cacheCopy.stats = c.stats
+	return cacheCopy
+}
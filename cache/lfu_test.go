@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// keys собирает ключи диапазона [begin, end).
+func keys[K any, V any](begin interfaces.ForwardIterator[pair.Pair[K, V]], end interfaces.Iterator) []K {
+	var result []K
+	for it := begin; !it.Equals(end); it.Next() {
+		result = append(result, it.Value().First)
+	}
+	return result
+}
+
+func TestLFUIterationOrder(t *testing.T) {
+	c := NewLFU[string, int](3, nil)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Peek("c")
+
+	assert.Equal(t, []string{"b", "a", "c"}, keys(c.Begin(), c.End()))
+	assert.Equal(t, []string{"a", "b", "c"}, keys(c.FrequencyBegin(), c.FrequencyEnd()))
+
+	c.Put("d", 4)
+	assert.Equal(t, []string{"d", "b", "a"}, keys(c.Begin(), c.End()))
+	assert.Equal(t, []string{"a", "b", "d"}, keys(c.FrequencyBegin(), c.FrequencyEnd()))
+
+	cacheCopy := copiable.Copy[*LFU[string, int]](c)
+	c.Get("d")
+	assert.Equal(t, []string{"d", "b", "a"}, keys(cacheCopy.Begin(), cacheCopy.End()))
+	assert.Equal(t, []string{"a", "b", "d"}, keys(cacheCopy.FrequencyBegin(), cacheCopy.FrequencyEnd()))
+	cacheCopy.Get("b")
+	cacheCopy.Put("e", 5)
+	assert.Equal(t, []string{"e", "b", "a"}, keys(cacheCopy.Begin(), cacheCopy.End()))
+}
@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/pair"
+	"github.com/Delisa-sama/collections/sequence/list"
+)
+
+// LRU представляет собой кеш ограниченной емкости с вытеснением давно не использованных элементов.
+// Элементы хранятся в двусвязном списке от недавно использованных к давно использованным.
+type LRU[K comparable, V any] struct {
+	capacity uint
+	items    *list.List[pair.Pair[K, V]]
+	index    map[K]interfaces.BidirectionalIterator[pair.Pair[K, V]]
+	onEvict  EvictCallback[K, V]
+	stats    Stats
+}
+
+// NewLRU создает новый LRU кеш заданной емкости.
+// onEvict вызывается для каждого вытесненного элемента и может быть nil.
+// Если емкость равна нулю, возникает паника.
+func NewLRU[K comparable, V any](capacity uint, onEvict EvictCallback[K, V]) *LRU[K, V] {
+	if capacity == 0 {
+		panic("cache capacity must be positive")
+	}
+	return &LRU[K, V]{
+		capacity: capacity,
+		items:    list.NewList[pair.Pair[K, V]](),
+		index:    make(map[K]interfaces.BidirectionalIterator[pair.Pair[K, V]], capacity),
+		onEvict:  onEvict,
+	}
+}
+
+// Capacity возвращает емкость кеша.
+func (c *LRU[K, V]) Capacity() uint {
+	return c.capacity
+}
+
+// Size возвращает количество элементов в кеше.
+func (c *LRU[K, V]) Size() uint {
+	return c.items.Size()
+}
+
+// IsEmpty проверяет что кеш пустой.
+func (c *LRU[K, V]) IsEmpty() bool {
+	return c.items.IsEmpty()
+}
+
+// Stats возвращает статистику обращений к кешу.
+func (c *LRU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats обнуляет статистику обращений к кешу.
+func (c *LRU[K, V]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// Get возвращает значение по ключу и признак его наличия.
+// Найденный элемент становится последним использованным. Обращение учитывается в статистике.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	it, found := c.index[key]
+	if !found {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.items.MoveToFront(it)
+	return it.Value().Second, true
+}
+
+// Peek возвращает значение по ключу и признак его наличия,
+// не изменяя порядок использования элементов и статистику.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	it, found := c.index[key]
+	if !found {
+		var zero V
+		return zero, false
+	}
+	return it.Value().Second, true
+}
+
+// Contains проверяет есть ли ключ в кеше, не изменяя порядок использования элементов и статистику.
+func (c *LRU[K, V]) Contains(key K) bool {
+	_, found := c.index[key]
+	return found
+}
+
+// Put добавляет пару ключ-значение в кеш или обновляет значение существующего ключа.
+// Элемент становится последним использованным. Если кеш заполнен, вытесняется давно не использованный элемент.
+// Возвращает true, если при вставке был вытеснен элемент.
+func (c *LRU[K, V]) Put(key K, value V) bool {
+	if it, found := c.index[key]; found {
+		it.Ptr().Second = value
+		c.items.MoveToFront(it)
+		return false
+	}
+
+	evicted := false
+	if c.items.Size() == c.capacity {
+		c.evict()
+		evicted = true
+	}
+	c.items.PushFront(pair.NewPair(key, value))
+	c.index[key] = c.items.Begin()
+	return evicted
+}
+
+// evict вытесняет давно не использованный элемент.
+func (c *LRU[K, V]) evict() {
+	oldest := c.items.Back()
+	erase(c.items, c.index[oldest.First])
+	delete(c.index, oldest.First)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(oldest.First, oldest.Second)
+	}
+}
+
+// Remove удаляет ключ из кеша. Функция вытеснения для удаленного элемента не вызывается.
+// Возвращает true в случае успешного удаления.
+func (c *LRU[K, V]) Remove(key K) bool {
+	it, found := c.index[key]
+	if !found {
+		return false
+	}
+	erase(c.items, it)
+	delete(c.index, key)
+	return true
+}
+
+// Resize изменяет емкость кеша, вытесняя давно не использованные элементы при необходимости.
+// Если емкость равна нулю, возникает паника.
+func (c *LRU[K, V]) Resize(capacity uint) {
+	if capacity == 0 {
+		panic("cache capacity must be positive")
+	}
+	c.capacity = capacity
+	for c.items.Size() > c.capacity {
+		c.evict()
+	}
+}
+
+// Clear удаляет все элементы из кеша без вызова функции вытеснения. Статистика сохраняется.
+func (c *LRU[K, V]) Clear() {
+	c.items = list.NewList[pair.Pair[K, V]]()
+	clear(c.index)
+}
+
+// Begin возвращает итератор на последний использованный элемент.
+// Элементы перебираются от недавно использованных к давно использованным.
+// Итерирование не изменяет порядок использования элементов. Изменение ключа через Ptr нарушает целостность кеша.
+func (c *LRU[K, V]) Begin() interfaces.BidirectionalIterator[pair.Pair[K, V]] {
+	return c.items.Begin()
+}
+
+// End возвращает итератор на конец кеша.
+func (c *LRU[K, V]) End() interfaces.Iterator {
+	return c.items.End()
+}
+
+// Copy возвращает копию кеша с тем же порядком использования элементов, функцией вытеснения и статистикой.
+func (c *LRU[K, V]) Copy() copiable.Copiable {
+	cacheCopy := NewLRU[K, V](c.capacity, c.onEvict)
+	for it := c.items.RBegin(); !it.Equals(c.items.REnd()); it.Next() {
+		cacheCopy.items.PushFront(it.Value())
+		cacheCopy.index[it.Value().First] = cacheCopy.items.Begin()
+	}
+	cacheCopy.stats = c.stats
+	return cacheCopy
+}
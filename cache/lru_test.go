@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+func TestLRUEvictionOrder(t *testing.T) {
+	var evicted []pair.Pair[string, int]
+	c := NewLRU[string, int](3, func(key string, value int) {
+		evicted = append(evicted, pair.NewPair(key, value))
+	})
+	assert.False(t, c.Put("a", 1))
+	assert.False(t, c.Put("b", 2))
+	assert.False(t, c.Put("c", 3))
+
+	// Обращение к "a" делает давно не использованным "b".
+	c.Get("a")
+	assert.True(t, c.Put("d", 4))
+	assert.Equal(t, []pair.Pair[string, int]{pair.NewPair("b", 2)}, evicted)
+	assert.False(t, c.Contains("b"))
+
+	// Обновление существующего ключа не вытесняет элементы, но делает ключ последним использованным.
+	assert.False(t, c.Put("c", 30))
+	assert.True(t, c.Put("e", 5))
+	assert.Equal(t, pair.NewPair("a", 1), evicted[1])
+
+	// Remove и Clear не вызывают функцию вытеснения.
+	assert.True(t, c.Remove("d"))
+	assert.False(t, c.Remove("d"))
+	c.Clear()
+	assert.True(t, c.IsEmpty())
+	assert.Len(t, evicted, 2)
+
+	c.Put("x", 1)
+	c.Put("y", 2)
+	c.Put("z", 3)
+	c.Resize(1)
+	assert.Equal(t, []pair.Pair[string, int]{pair.NewPair("x", 1), pair.NewPair("y", 2)}, evicted[2:])
+	assert.Equal(t, []string{"z"}, keys(c.Begin(), c.End()))
+	assert.Panics(t, func() { c.Resize(0) })
+}
+
+func TestLRUStats(t *testing.T) {
+	c := NewLRU[string, int](2, nil)
+	assert.Zero(t, c.Stats().HitRate())
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	_, ok = c.Get("missing")
+	assert.False(t, ok)
+	c.Get("b")
+	c.Put("c", 3)
+
+	// Peek и Contains не учитываются в статистике.
+	c.Peek("b")
+	c.Peek("missing")
+	c.Contains("a")
+
+	assert.Equal(t, Stats{Hits: 2, Misses: 1, Evictions: 1}, c.Stats())
+	assert.InDelta(t, 2.0/3.0, c.Stats().HitRate(), 1e-9)
+
+	c.Clear()
+	assert.Equal(t, uint64(1), c.Stats().Evictions)
+	c.ResetStats()
+	assert.Equal(t, Stats{}, c.Stats())
+}
+
+func TestLRUIterationOrder(t *testing.T) {
+	c := NewLRU[string, int](4, nil)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Put("d", 4)
+	assert.Equal(t, []string{"d", "c", "b", "a"}, keys(c.Begin(), c.End()))
+
+	c.Get("b")
+	assert.Equal(t, []string{"b", "d", "c", "a"}, keys(c.Begin(), c.End()))
+
+	// Peek не изменяет порядок использования.
+	value, ok := c.Peek("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, []string{"b", "d", "c", "a"}, keys(c.Begin(), c.End()))
+
+	c.Get("a")
+	c.Put("c", 30)
+	assert.Equal(t, []string{"c", "a", "b", "d"}, keys(c.Begin(), c.End()))
+
+	cacheCopy := copiable.Copy[*LRU[string, int]](c)
+	c.Get("d")
+	assert.Equal(t, []string{"d", "c", "a", "b"}, keys(c.Begin(), c.End()))
+	assert.Equal(t, []string{"c", "a", "b", "d"}, keys(cacheCopy.Begin(), cacheCopy.End()))
+	cacheCopy.Put("e", 5)
+	assert.Equal(t, []string{"e", "c", "a", "b"}, keys(cacheCopy.Begin(), cacheCopy.End()))
+	assert.True(t, c.Contains("d"))
+}
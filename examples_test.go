@@ -164,6 +164,9 @@ func Test_Examples(t *testing.T) {
 		if _, found := avl1.Find("5"); !found {
 			fmt.Println("not found ")
 		}
+		if avl1.Delete("4") {
+			fmt.Println("deleted, size ", avl1.Size())
+		}
 		fmt.Println()
 
 		_, _ = algorithms.PrintF[pair.Pair[string, int]](avl1.InOrderBegin(), avl1.InOrderEnd(), "%v ")
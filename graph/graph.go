@@ -0,0 +1,266 @@
+package graph
+
+import (
+	"github.com/elliotchance/orderedmap/v2"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// adjacency - список смежности вершины: соседи и веса ребер к ним в порядке добавления ребер.
+type adjacency[V comparable, W any] = orderedmap.OrderedMap[V, W]
+
+// Graph представляет собой ориентированный или неориентированный взвешенный граф на списках смежности.
+// Вершины и соседи вершины перебираются в порядке добавления. Между парой вершин может быть не более одного ребра.
+type Graph[V comparable, W any] struct {
+	out      *orderedmap.OrderedMap[V, *adjacency[V, W]]
+	in       map[V]*adjacency[V, W] // Входящие ребра, только для ориентированного графа.
+	directed bool
+	edges    uint
+}
+
+// NewDirectedGraph создает новый пустой ориентированный граф.
+func NewDirectedGraph[V comparable, W any]() *Graph[V, W] {
+	return &Graph[V, W]{
+		out:      orderedmap.NewOrderedMap[V, *adjacency[V, W]](),
+		in:       make(map[V]*adjacency[V, W]),
+		directed: true,
+	}
+}
+
+// NewUndirectedGraph создает новый пустой неориентированный граф.
+func NewUndirectedGraph[V comparable, W any]() *Graph[V, W] {
+	return &Graph[V, W]{
+		out: orderedmap.NewOrderedMap[V, *adjacency[V, W]](),
+	}
+}
+
+// IsDirected проверяет, является ли граф ориентированным.
+func (g *Graph[V, W]) IsDirected() bool {
+	return g.directed
+}
+
+// Size возвращает количество вершин графа.
+func (g *Graph[V, W]) Size() uint {
+	return uint(g.out.Len())
+}
+
+// IsEmpty проверяет что граф не содержит вершин.
+func (g *Graph[V, W]) IsEmpty() bool {
+	return g.out.Len() == 0
+}
+
+// EdgeCount возвращает количество ребер графа.
+func (g *Graph[V, W]) EdgeCount() uint {
+	return g.edges
+}
+
+// AddVertex добавляет вершину в граф.
+// Возвращает false, если вершина уже есть в графе.
+func (g *Graph[V, W]) AddVertex(v V) bool {
+	if _, found := g.out.Get(v); found {
+		return false
+	}
+	g.out.Set(v, orderedmap.NewOrderedMap[V, W]())
+	if g.directed {
+		g.in[v] = orderedmap.NewOrderedMap[V, W]()
+	}
+	return true
+}
+
+// HasVertex проверяет есть ли вершина в графе.
+func (g *Graph[V, W]) HasVertex(v V) bool {
+	_, found := g.out.Get(v)
+	return found
+}
+
+// RemoveVertex удаляет вершину вместе со всеми инцидентными ей ребрами.
+// Возвращает true в случае успешного удаления.
+func (g *Graph[V, W]) RemoveVertex(v V) bool {
+	adj, found := g.out.Get(v)
+	if !found {
+		return false
+	}
+	for _, to := range adj.Keys() {
+		g.removeEdge(v, to)
+	}
+	if g.directed {
+		for _, from := range g.in[v].Keys() {
+			g.removeEdge(from, v)
+		}
+		delete(g.in, v)
+	}
+	g.out.Delete(v)
+	return true
+}
+
+// AddEdge добавляет ребро из from в to с весом weight. Отсутствующие вершины предварительно добавляются.
+// Если ребро уже существует, его вес заменяется.
+// Возвращает true, если ребро было добавлено.
+func (g *Graph[V, W]) AddEdge(from, to V, weight W) bool {
+	g.AddVertex(from)
+	g.AddVertex(to)
+	adj, _ := g.out.Get(from)
+	added := adj.Set(to, weight)
+	if g.directed {
+		g.in[to].Set(from, weight)
+	} else {
+		back, _ := g.out.Get(to)
+		back.Set(from, weight)
+	}
+	if added {
+		g.edges++
+	}
+	return added
+}
+
+// HasEdge проверяет есть ли в графе ребро из from в to.
+func (g *Graph[V, W]) HasEdge(from, to V) bool {
+	_, found := g.Weight(from, to)
+	return found
+}
+
+// Weight возвращает вес ребра из from в to и признак его наличия.
+func (g *Graph[V, W]) Weight(from, to V) (W, bool) {
+	adj, found := g.out.Get(from)
+	if !found {
+		var zero W
+		return zero, false
+	}
+	return adj.Get(to)
+}
+
+// RemoveEdge удаляет ребро из from в to.
+// Возвращает true в случае успешного удаления.
+func (g *Graph[V, W]) RemoveEdge(from, to V) bool {
+	if !g.HasEdge(from, to) {
+		return false
+	}
+	g.removeEdge(from, to)
+	return true
+}
+
+// removeEdge удаляет существующее ребро из from в to.
+func (g *Graph[V, W]) removeEdge(from, to V) {
+	adj, _ := g.out.Get(from)
+	adj.Delete(to)
+	if g.directed {
+		g.in[to].Delete(from)
+	} else {
+		back, _ := g.out.Get(to)
+		back.Delete(from)
+	}
+	g.edges--
+}
+
+// Degree возвращает количество соседей вершины, для ориентированного графа - количество исходящих ребер.
+// Если вершины нет в графе, возвращает 0.
+func (g *Graph[V, W]) Degree(v V) uint {
+	adj, found := g.out.Get(v)
+	if !found {
+		return 0
+	}
+	return uint(adj.Len())
+}
+
+// InDegree возвращает количество входящих в вершину ребер. Для неориентированного графа совпадает с Degree.
+func (g *Graph[V, W]) InDegree(v V) uint {
+	if !g.directed {
+		return g.Degree(v)
+	}
+	adj, found := g.in[v]
+	if !found {
+		return 0
+	}
+	return uint(adj.Len())
+}
+
+// ForEachNeighbor вызывает f для каждого соседа вершины v и веса ребра к нему в порядке добавления ребер.
+// Метод имеет тип algorithms.Neighbors, поэтому его можно передавать в алгоритмы на графах
+// пакета algorithms, например algorithms.Dijkstra или algorithms.TopologicalSort.
+func (g *Graph[V, W]) ForEachNeighbor(v V, f func(to V, weight W)) {
+	adj, found := g.out.Get(v)
+	if !found {
+		return
+	}
+	for el := adj.Front(); el != nil; el = el.Next() {
+		f(el.Key, el.Value)
+	}
+}
+
+// Clear удаляет все вершины и ребра графа.
+func (g *Graph[V, W]) Clear() {
+	g.out = orderedmap.NewOrderedMap[V, *adjacency[V, W]]()
+	if g.directed {
+		g.in = make(map[V]*adjacency[V, W])
+	}
+	g.edges = 0
+}
+
+// VerticesBegin возвращает итератор на первую добавленную вершину графа.
+func (g *Graph[V, W]) VerticesBegin() interfaces.ForwardIterator[V] {
+	return newVertexIterator(g.out.Front())
+}
+
+// VerticesEnd возвращает итератор на конец последовательности вершин.
+func (g *Graph[V, W]) VerticesEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// NeighborsBegin возвращает итератор по парам из соседа вершины v и веса ребра к нему.
+// Если вершины нет в графе, возвращает конечный итератор.
+func (g *Graph[V, W]) NeighborsBegin(v V) interfaces.ForwardIterator[pair.Pair[V, W]] {
+	adj, found := g.out.Get(v)
+	if !found {
+		return newNeighborIterator[V, W](nil)
+	}
+	return newNeighborIterator(adj.Front())
+}
+
+// NeighborsEnd возвращает итератор на конец последовательности соседей.
+func (g *Graph[V, W]) NeighborsEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// BFSBegin возвращает итератор обхода в ширину вершин, достижимых из start.
+// Если вершины нет в графе, возвращает конечный итератор.
+func (g *Graph[V, W]) BFSBegin(start V) interfaces.ForwardIterator[V] {
+	return newBFSIterator(g, start)
+}
+
+// BFSEnd возвращает итератор на конец обхода в ширину.
+func (g *Graph[V, W]) BFSEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// DFSBegin возвращает итератор обхода в глубину вершин, достижимых из start, в порядке их открытия.
+// Если вершины нет в графе, возвращает конечный итератор.
+func (g *Graph[V, W]) DFSBegin(start V) interfaces.ForwardIterator[V] {
+	return newDFSIterator(g, start)
+}
+
+// DFSEnd возвращает итератор на конец обхода в глубину.
+func (g *Graph[V, W]) DFSEnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию графа.
+func (g *Graph[V, W]) Copy() copiable.Copiable {
+	graphCopy := &Graph[V, W]{
+		out:      orderedmap.NewOrderedMap[V, *adjacency[V, W]](),
+		directed: g.directed,
+		edges:    g.edges,
+	}
+	for el := g.out.Front(); el != nil; el = el.Next() {
+		graphCopy.out.Set(el.Key, el.Value.Copy())
+	}
+	if g.directed {
+		graphCopy.in = make(map[V]*adjacency[V, W], len(g.in))
+		for v, adj := range g.in {
+			graphCopy.in[v] = adj.Copy()
+		}
+	}
+	return graphCopy
+}
@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"github.com/elliotchance/orderedmap/v2"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/pair"
+)
+
+// vertexIterator представляет собой прямой итератор по вершинам графа в порядке добавления.
+type vertexIterator[V comparable, W any] struct {
+	current *orderedmap.Element[V, *adjacency[V, W]]
+}
+
+// newVertexIterator создает итератор, указывающий на вершину el.
+func newVertexIterator[V comparable, W any](el *orderedmap.Element[V, *adjacency[V, W]]) *vertexIterator[V, W] {
+	return &vertexIterator[V, W]{current: el}
+}
+
+// HasNext проверяет, указывает ли итератор на вершину.
+func (it *vertexIterator[V, W]) HasNext() bool {
+	return it.current != nil
+}
+
+// Next переходит к следующей вершине.
+func (it *vertexIterator[V, W]) Next() {
+	it.current = it.current.Next()
+}
+
+// Value возвращает текущую вершину.
+func (it *vertexIterator[V, W]) Value() V {
+	return it.current.Key
+}
+
+// Ptr возвращает указатель на копию текущей вершины.
+func (it *vertexIterator[V, W]) Ptr() *V {
+	v := it.current.Key
+	return &v
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *vertexIterator[V, W]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *vertexIterator[V, W]:
+		return it.current == a.current
+	case *iterators.EndIterator:
+		return it.current == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *vertexIterator[V, W]) Copy() copiable.Copiable {
+	return newVertexIterator(it.current)
+}
+
+// neighborIterator представляет собой прямой итератор по соседям вершины в порядке добавления ребер.
+type neighborIterator[V comparable, W any] struct {
+	current *orderedmap.Element[V, W]
+}
+
+// newNeighborIterator создает итератор, указывающий на соседа el.
+func newNeighborIterator[V comparable, W any](el *orderedmap.Element[V, W]) *neighborIterator[V, W] {
+	return &neighborIterator[V, W]{current: el}
+}
+
+// HasNext проверяет, указывает ли итератор на соседа.
+func (it *neighborIterator[V, W]) HasNext() bool {
+	return it.current != nil
+}
+
+// Next переходит к следующему соседу.
+func (it *neighborIterator[V, W]) Next() {
+	it.current = it.current.Next()
+}
+
+// Value возвращает пару из текущего соседа и веса ребра к нему.
+func (it *neighborIterator[V, W]) Value() pair.Pair[V, W] {
+	return pair.NewPair(it.current.Key, it.current.Value)
+}
+
+// Ptr возвращает указатель на копию пары из текущего соседа и веса ребра к нему.
+// Вес ребра изменяется методом AddEdge.
+func (it *neighborIterator[V, W]) Ptr() *pair.Pair[V, W] {
+	p := it.Value()
+	return &p
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *neighborIterator[V, W]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *neighborIterator[V, W]:
+		return it.current == a.current
+	case *iterators.EndIterator:
+		return it.current == nil
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *neighborIterator[V, W]) Copy() copiable.Copiable {
+	return newNeighborIterator(it.current)
+}
@@ -0,0 +1,181 @@
+package graph
+
+import (
+	"github.com/Delisa-sama/collections/adapters/queue"
+	"github.com/Delisa-sama/collections/adapters/stack"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+	"github.com/Delisa-sama/collections/sequence/list"
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+// copyVisited возвращает копию множества посещенных вершин.
+func copyVisited[V comparable](visited map[V]struct{}) map[V]struct{} {
+	visitedCopy := make(map[V]struct{}, len(visited))
+	for v := range visited {
+		visitedCopy[v] = struct{}{}
+	}
+	return visitedCopy
+}
+
+// bfsIterator представляет собой итератор обхода графа в ширину.
+// Соседи текущей вершины добавляются в очередь при переходе к следующей вершине.
+// Изменение графа во время обхода инвалидирует итератор.
+type bfsIterator[V comparable, W any] struct {
+	g       *Graph[V, W]
+	current V
+	done    bool
+	visited map[V]struct{} // Вершины, когда-либо добавленные в очередь.
+	q       *queue.Queue[V, *list.List[V]]
+}
+
+// newBFSIterator создает итератор обхода в ширину, указывающий на start.
+func newBFSIterator[V comparable, W any](g *Graph[V, W], start V) *bfsIterator[V, W] {
+	it := &bfsIterator[V, W]{
+		g:       g,
+		current: start,
+		done:    !g.HasVertex(start),
+		visited: map[V]struct{}{start: {}},
+		q:       queue.NewQueue(list.NewList[V]),
+	}
+	return it
+}
+
+// HasNext проверяет, указывает ли итератор на вершину.
+func (it *bfsIterator[V, W]) HasNext() bool {
+	return !it.done
+}
+
+// Next переходит к следующей вершине обхода.
+func (it *bfsIterator[V, W]) Next() {
+	it.g.ForEachNeighbor(it.current, func(to V, _ W) {
+		if _, seen := it.visited[to]; !seen {
+			it.visited[to] = struct{}{}
+			it.q.PushBack(to)
+		}
+	})
+	if it.q.IsEmpty() {
+		it.done = true
+		return
+	}
+	it.current = it.q.Front()
+	it.q.PopFront()
+}
+
+// Value возвращает текущую вершину.
+func (it *bfsIterator[V, W]) Value() V {
+	return it.current
+}
+
+// Ptr возвращает указатель на копию текущей вершины.
+func (it *bfsIterator[V, W]) Ptr() *V {
+	v := it.current
+	return &v
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *bfsIterator[V, W]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *bfsIterator[V, W]:
+		return it.done == a.done && (it.done || it.current == a.current)
+	case *iterators.EndIterator:
+		return it.done
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор вместе с состоянием обхода.
+func (it *bfsIterator[V, W]) Copy() copiable.Copiable {
+	return &bfsIterator[V, W]{
+		g:       it.g,
+		current: it.current,
+		done:    it.done,
+		visited: copyVisited(it.visited),
+		q:       copiable.Copy[*queue.Queue[V, *list.List[V]]](it.q),
+	}
+}
+
+// dfsIterator представляет собой итератор обхода графа в глубину в порядке открытия вершин.
+// Соседи вершины обходятся в порядке добавления ребер.
+// Изменение графа во время обхода инвалидирует итератор.
+type dfsIterator[V comparable, W any] struct {
+	g       *Graph[V, W]
+	current V
+	done    bool
+	visited map[V]struct{} // Открытые вершины.
+	s       *stack.Stack[V, *vector.Vector[V]]
+}
+
+// newDFSIterator создает итератор обхода в глубину, указывающий на start.
+func newDFSIterator[V comparable, W any](g *Graph[V, W], start V) *dfsIterator[V, W] {
+	return &dfsIterator[V, W]{
+		g:       g,
+		current: start,
+		done:    !g.HasVertex(start),
+		visited: map[V]struct{}{start: {}},
+		s:       stack.NewStack(vector.NewVector[V]),
+	}
+}
+
+// HasNext проверяет, указывает ли итератор на вершину.
+func (it *dfsIterator[V, W]) HasNext() bool {
+	return !it.done
+}
+
+// Next переходит к следующей вершине обхода.
+func (it *dfsIterator[V, W]) Next() {
+	// Соседи кладутся на стек в обратном порядке, чтобы первым был открыт первый сосед.
+	var neighbors []V
+	it.g.ForEachNeighbor(it.current, func(to V, _ W) {
+		if _, seen := it.visited[to]; !seen {
+			neighbors = append(neighbors, to)
+		}
+	})
+	for i := len(neighbors) - 1; i >= 0; i-- {
+		it.s.Push(neighbors[i])
+	}
+	for !it.s.IsEmpty() {
+		v := it.s.Top()
+		it.s.Pop()
+		if _, seen := it.visited[v]; !seen {
+			it.visited[v] = struct{}{}
+			it.current = v
+			return
+		}
+	}
+	it.done = true
+}
+
+// Value возвращает текущую вершину.
+func (it *dfsIterator[V, W]) Value() V {
+	return it.current
+}
+
+// Ptr возвращает указатель на копию текущей вершины.
+func (it *dfsIterator[V, W]) Ptr() *V {
+	v := it.current
+	return &v
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *dfsIterator[V, W]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *dfsIterator[V, W]:
+		return it.done == a.done && (it.done || it.current == a.current)
+	case *iterators.EndIterator:
+		return it.done
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор вместе с состоянием обхода.
+func (it *dfsIterator[V, W]) Copy() copiable.Copiable {
+	return &dfsIterator[V, W]{
+		g:       it.g,
+		current: it.current,
+		done:    it.done,
+		visited: copyVisited(it.visited),
+		s:       it.s.Copy(),
+	}
+}
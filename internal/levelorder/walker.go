@@ -0,0 +1,74 @@
+package levelorder
+
+import (
+	"github.com/Delisa-sama/collections/adapters/queue"
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/sequence/list"
+)
+
+// Children - это функция, возвращающая левого и правого потомка узла двоичного дерева.
+type Children[N any] func(n *N) (left, right *N)
+
+// item представляет узел в очереди обхода вместе с его глубиной.
+type item[N any] struct {
+	node  *N
+	depth uint
+}
+
+// Walker выполняет level-order (в ширину) обход двоичного дерева с узлами типа N.
+// Используется итераторами деревьев, которые отличаются только типом узла и способом получения значения.
+type Walker[N any] struct {
+	children Children[N]
+	current  item[N]
+	q        *queue.Queue[item[N], *list.List[item[N]]]
+}
+
+// NewWalker создаёт новый Walker, указывающий на корень дерева root.
+// Если root равен nil, обход сразу завершён.
+func NewWalker[N any](root *N, children Children[N]) *Walker[N] {
+	w := &Walker[N]{
+		children: children,
+		q:        queue.NewQueue(list.NewList[item[N]]),
+	}
+	if root != nil {
+		w.q.PushBack(item[N]{node: root, depth: 0})
+	}
+	w.Next()
+	return w
+}
+
+// Node возвращает текущий узел или nil, если обход завершён.
+func (w *Walker[N]) Node() *N {
+	return w.current.node
+}
+
+// Depth возвращает глубину текущего узла (глубина корня равна 0).
+func (w *Walker[N]) Depth() uint {
+	return w.current.depth
+}
+
+// Next перемещает обход к следующему узлу.
+func (w *Walker[N]) Next() {
+	if w.q.IsEmpty() {
+		w.current = item[N]{}
+		return
+	}
+	w.current = w.q.Front()
+	w.q.PopFront()
+	left, right := w.children(w.current.node)
+	if left != nil {
+		w.q.PushBack(item[N]{node: left, depth: w.current.depth + 1})
+	}
+	if right != nil {
+		w.q.PushBack(item[N]{node: right, depth: w.current.depth + 1})
+	}
+}
+
+// Copy копирует состояние обхода.
+func (w *Walker[N]) Copy() copiable.Copiable {
+	return &Walker[N]{
+		children: w.children,
+		current:  w.current,
+		q:        copiable.Copy[*queue.Queue[item[N], *list.List[item[N]]]](w.q),
+	}
+}
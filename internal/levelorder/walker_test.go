@@ -0,0 +1,50 @@
+package levelorder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/copiable"
+)
+
+type testNode struct {
+	value       int
+	left, right *testNode
+}
+
+func testChildren(n *testNode) (*testNode, *testNode) {
+	return n.left, n.right
+}
+
+func TestWalker(t *testing.T) {
+	//       1
+	//     2   3
+	//    4   5 6
+	root := &testNode{
+		value: 1,
+		left:  &testNode{value: 2, left: &testNode{value: 4}},
+		right: &testNode{value: 3, left: &testNode{value: 5}, right: &testNode{value: 6}},
+	}
+
+	var values []int
+	var depths []uint
+	var copied *Walker[testNode]
+	for w := NewWalker(root, testChildren); w.Node() != nil; w.Next() {
+		values = append(values, w.Node().value)
+		depths = append(depths, w.Depth())
+		if w.Node().value == 3 {
+			copied = copiable.Copy[*Walker[testNode]](w)
+		}
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, values)
+	assert.Equal(t, []uint{0, 1, 1, 2, 2, 2}, depths)
+
+	values = nil
+	for ; copied.Node() != nil; copied.Next() {
+		values = append(values, copied.Node().value)
+	}
+	assert.Equal(t, []int{3, 4, 5, 6}, values)
+
+	assert.Nil(t, NewWalker(nil, testChildren).Node())
+}
@@ -0,0 +1,89 @@
+package projection
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// Project - это функция, возвращающая указатель на часть элемента, которую видит пользователь итератора.
+type Project[From any, To any] func(p *From) *To
+
+// Iterator представляет собой итератор с произвольным доступом, адаптирующий итератор
+// по элементам типа From и возвращающий их проекции типа To.
+// Используется контейнерами, построенными поверх других контейнеров, например мультимножеством поверх мультиотображения.
+type Iterator[From any, To any] struct {
+	it      interfaces.RandomAccessIterator[From]
+	project Project[From, To]
+}
+
+// NewIterator создает новый итератор, возвращающий проекции элементов it.
+func NewIterator[From any, To any](it interfaces.RandomAccessIterator[From], project Project[From, To]) *Iterator[From, To] {
+	return &Iterator[From, To]{it: it, project: project}
+}
+
+// HasNext проверяет, есть ли ещё элементы для обхода.
+func (it *Iterator[From, To]) HasNext() bool {
+	return it.it.HasNext()
+}
+
+// Next переходит к следующему элементу.
+func (it *Iterator[From, To]) Next() {
+	it.it.Next()
+}
+
+// HasPrev проверяет, есть ли предыдущий элемент.
+func (it *Iterator[From, To]) HasPrev() bool {
+	return it.it.HasPrev()
+}
+
+// Prev переходит к предыдущему элементу.
+func (it *Iterator[From, To]) Prev() {
+	it.it.Prev()
+}
+
+// Value возвращает текущее значение итератора.
+func (it *Iterator[From, To]) Value() To {
+	return *it.project(it.it.Ptr())
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+func (it *Iterator[From, To]) Ptr() *To {
+	return it.project(it.it.Ptr())
+}
+
+// At возвращает указатель на элемент с заданной позицией.
+func (it *Iterator[From, To]) At(index uint) (*To, bool) {
+	p, ok := it.it.At(index)
+	if !ok {
+		return nil, false
+	}
+	return it.project(p), true
+}
+
+// Shift смещает итератор на заданное количество элементов.
+// Если смещение положительное - смещает вперед, если отрицательное - назад.
+func (it *Iterator[From, To]) Shift(offset int) {
+	it.it.Shift(offset)
+}
+
+// Index возвращает позицию текущего элемента.
+func (it *Iterator[From, To]) Index() uint {
+	return it.it.Index()
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *Iterator[From, To]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *Iterator[From, To]:
+		return it.it.Equals(a.it)
+	case *iterators.EndIterator:
+		return it.it.Equals(a)
+	}
+	panic("unknown iterator type")
+}
+
+// Copy копирует итератор.
+func (it *Iterator[From, To]) Copy() copiable.Copiable {
+	return NewIterator(copiable.Copy[interfaces.RandomAccessIterator[From]](it.it), it.project)
+}
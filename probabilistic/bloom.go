@@ -0,0 +1,156 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/Delisa-sama/collections/associative/hashmap"
+	"github.com/Delisa-sama/collections/copiable"
+)
+
+// BloomFilter представляет собой фильтр Блума - вероятностное множество.
+// Contains может ошибочно сообщить о наличии элемента, но никогда не ошибается в его отсутствии.
+type BloomFilter[T any] struct {
+	words  []uint64
+	m      uint64 // Количество бит.
+	k      uint32 // Количество хеш-функций.
+	hasher hashmap.Hasher[T]
+}
+
+// NewBloomFilter создает фильтр Блума, рассчитанный на n элементов с вероятностью ложноположительного ответа fpRate.
+// Если n равно нулю или fpRate не лежит в интервале (0, 1), возникает паника.
+func NewBloomFilter[T any](n uint, fpRate float64, hasher hashmap.Hasher[T]) *BloomFilter[T] {
+	if n == 0 {
+		panic("expected number of elements must be positive")
+	}
+	if fpRate <= 0 || fpRate >= 1 {
+		panic("false positive rate must be in (0, 1)")
+	}
+	m := uint64(math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	k := uint32(max(1, math.Round(float64(m)/float64(n)*math.Ln2)))
+	return &BloomFilter[T]{
+		words:  make([]uint64, (m+63)/64),
+		m:      m,
+		k:      k,
+		hasher: hasher,
+	}
+}
+
+// BitCount возвращает количество бит фильтра.
+func (f *BloomFilter[T]) BitCount() uint64 {
+	return f.m
+}
+
+// HashCount возвращает количество хеш-функций фильтра.
+func (f *BloomFilter[T]) HashCount() uint32 {
+	return f.k
+}
+
+// Add добавляет элемент в фильтр.
+func (f *BloomFilter[T]) Add(x T) {
+	h1, h2 := hashes(f.hasher(x))
+	for i := uint64(0); i < uint64(f.k); i++ {
+		pos := (h1 + i*h2) % f.m
+		f.words[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+// Contains проверяет, мог ли элемент быть добавлен в фильтр.
+func (f *BloomFilter[T]) Contains(x T) bool {
+	h1, h2 := hashes(f.hasher(x))
+	for i := uint64(0); i < uint64(f.k); i++ {
+		pos := (h1 + i*h2) % f.m
+		if f.words[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// setBits возвращает количество установленных бит.
+func (f *BloomFilter[T]) setBits() uint64 {
+	var n int
+	for _, w := range f.words {
+		n += bits.OnesCount64(w)
+	}
+	return uint64(n)
+}
+
+// EstimatedCount оценивает количество различных элементов, добавленных в фильтр, по доле установленных бит.
+func (f *BloomFilter[T]) EstimatedCount() uint64 {
+	x := float64(f.setBits())
+	if x == float64(f.m) {
+		return math.MaxUint64
+	}
+	m, k := float64(f.m), float64(f.k)
+	return uint64(math.Round(-m / k * math.Log(1-x/m)))
+}
+
+// FalsePositiveRate оценивает текущую вероятность ложноположительного ответа по доле установленных бит.
+func (f *BloomFilter[T]) FalsePositiveRate() float64 {
+	return math.Pow(float64(f.setBits())/float64(f.m), float64(f.k))
+}
+
+// Merge добавляет в фильтр все элементы фильтра other.
+// Фильтры должны иметь одинаковые параметры и хеш-функцию, иначе возвращается ErrIncompatible.
+func (f *BloomFilter[T]) Merge(other *BloomFilter[T]) error {
+	if f.m != other.m || f.k != other.k {
+		return ErrIncompatible
+	}
+	for i := range f.words {
+		f.words[i] |= other.words[i]
+	}
+	return nil
+}
+
+// Clear удаляет все элементы из фильтра.
+func (f *BloomFilter[T]) Clear() {
+	clear(f.words)
+}
+
+// Copy возвращает копию фильтра.
+func (f *BloomFilter[T]) Copy() copiable.Copiable {
+	return &BloomFilter[T]{
+		words:  slices.Clone(f.words),
+		m:      f.m,
+		k:      f.k,
+		hasher: f.hasher,
+	}
+}
+
+// MarshalBinary сериализует фильтр. Хеш-функция не сериализуется.
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	buf := appendHeader(kindBloomFilter, 12+8*len(f.words))
+	buf = binary.LittleEndian.AppendUint64(buf, f.m)
+	buf = binary.LittleEndian.AppendUint32(buf, f.k)
+	for _, w := range f.words {
+		buf = binary.LittleEndian.AppendUint64(buf, w)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary заменяет содержимое и параметры фильтра сериализованными данными.
+// Хеш-функция фильтра сохраняется и должна совпадать с хеш-функцией сериализованного фильтра.
+func (f *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	data, err := readHeader(data, kindBloomFilter)
+	if err != nil {
+		return err
+	}
+	if len(data) < 12 {
+		return ErrInvalidData
+	}
+	m := binary.LittleEndian.Uint64(data)
+	k := binary.LittleEndian.Uint32(data[8:])
+	data = data[12:]
+	if m == 0 || k == 0 || uint64(len(data)) != (m+63)/64*8 {
+		return ErrInvalidData
+	}
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	f.words, f.m, f.k = words, m, k
+	return nil
+}
@@ -0,0 +1,75 @@
+package probabilistic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilterFalsePositiveRate(t *testing.T) {
+	const (
+		n      = 10000
+		fpRate = 0.01
+	)
+	f := NewBloomFilter(n, fpRate, StableHasher[string]())
+	for _, key := range keys("in-", n) {
+		f.Add(key)
+	}
+	for _, key := range keys("in-", n) {
+		require.True(t, f.Contains(key))
+	}
+
+	falsePositives := 0
+	absent := keys("out-", 10*n)
+	for _, key := range absent {
+		if f.Contains(key) {
+			falsePositives++
+		}
+	}
+	rate := float64(falsePositives) / float64(len(absent))
+	assert.Less(t, rate, 1.5*fpRate)
+	assert.InDelta(t, fpRate, f.FalsePositiveRate(), fpRate/2)
+	assert.InEpsilon(t, n, f.EstimatedCount(), 0.05)
+}
+
+func TestBloomFilterMarshalRoundTrip(t *testing.T) {
+	hasher := StableHasher[string]()
+	f := NewBloomFilter(1000, 0.01, hasher)
+	for _, key := range keys("k", 500) {
+		f.Add(key)
+	}
+	// Параметры восстанавливаются из данных, а не берутся из получателя.
+	restored := NewBloomFilter(10, 0.5, hasher)
+	checkRoundTrip(t, f, restored)
+	assert.Equal(t, f.BitCount(), restored.BitCount())
+	assert.Equal(t, f.HashCount(), restored.HashCount())
+	for _, key := range keys("k", 500) {
+		assert.True(t, restored.Contains(key))
+	}
+}
+
+func TestBloomFilterUnmarshalInvalidData(t *testing.T) {
+	hasher := StableHasher[string]()
+	f := NewBloomFilter(1000, 0.01, hasher)
+	f.Add("a")
+	target := NewBloomFilter(100, 0.1, hasher)
+	target.Add("b")
+	checkInvalidData(t, f, target)
+}
+
+func TestBloomFilterMergeIncompatible(t *testing.T) {
+	hasher := StableHasher[string]()
+	f := NewBloomFilter(1000, 0.01, hasher)
+	f.Add("a")
+	assert.ErrorIs(t, f.Merge(NewBloomFilter(2000, 0.01, hasher)), ErrIncompatible)
+	assert.ErrorIs(t, f.Merge(NewBloomFilter(1000, 0.001, hasher)), ErrIncompatible)
+	assert.True(t, f.Contains("a"))
+}
+
+func TestBloomFilterMergeAcrossWorkers(t *testing.T) {
+	checkMergeAcrossWorkers(t, keys("k", 5000),
+		func() *BloomFilter[string] { return NewBloomFilter(5000, 0.01, StableHasher[string]()) },
+		func(f *BloomFilter[string], item string) { f.Add(item) },
+	)
+}
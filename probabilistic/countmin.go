@@ -0,0 +1,146 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"math"
+	"slices"
+
+	"github.com/Delisa-sama/collections/associative/hashmap"
+	"github.com/Delisa-sama/collections/copiable"
+)
+
+// CountMinSketch представляет собой скетч Count-Min для оценки частот элементов потока.
+// Оценка частоты никогда не бывает меньше истинной и с вероятностью не менее 1 - delta
+// превышает ее не более чем на epsilon * Total().
+type CountMinSketch[T any] struct {
+	counts []uint64 // Матрица счетчиков depth x width по строкам.
+	width  uint32
+	depth  uint32
+	total  uint64
+	hasher hashmap.Hasher[T]
+}
+
+// NewCountMinSketch создает скетч с относительной погрешностью epsilon и вероятностью ее превышения delta.
+// Если epsilon или delta не лежат в интервале (0, 1), возникает паника.
+func NewCountMinSketch[T any](epsilon, delta float64, hasher hashmap.Hasher[T]) *CountMinSketch[T] {
+	if epsilon <= 0 || epsilon >= 1 {
+		panic("epsilon must be in (0, 1)")
+	}
+	if delta <= 0 || delta >= 1 {
+		panic("delta must be in (0, 1)")
+	}
+	width := uint32(math.Ceil(math.E / epsilon))
+	depth := uint32(math.Ceil(math.Log(1 / delta)))
+	return &CountMinSketch[T]{
+		counts: make([]uint64, int(width)*int(depth)),
+		width:  width,
+		depth:  depth,
+		hasher: hasher,
+	}
+}
+
+// Width возвращает количество счетчиков в строке скетча.
+func (s *CountMinSketch[T]) Width() uint32 {
+	return s.width
+}
+
+// Depth возвращает количество строк скетча.
+func (s *CountMinSketch[T]) Depth() uint32 {
+	return s.depth
+}
+
+// Total возвращает сумму всех добавленных количеств.
+func (s *CountMinSketch[T]) Total() uint64 {
+	return s.total
+}
+
+// cell возвращает индекс счетчика элемента с хешами h1, h2 в строке row.
+func (s *CountMinSketch[T]) cell(row uint32, h1, h2 uint64) int {
+	return int(row)*int(s.width) + int((h1+uint64(row)*h2)%uint64(s.width))
+}
+
+// Add увеличивает частоту элемента на count.
+func (s *CountMinSketch[T]) Add(x T, count uint64) {
+	h1, h2 := hashes(s.hasher(x))
+	for row := uint32(0); row < s.depth; row++ {
+		s.counts[s.cell(row, h1, h2)] += count
+	}
+	s.total += count
+}
+
+// Estimate возвращает оценку частоты элемента сверху.
+func (s *CountMinSketch[T]) Estimate(x T) uint64 {
+	h1, h2 := hashes(s.hasher(x))
+	estimate := uint64(math.MaxUint64)
+	for row := uint32(0); row < s.depth; row++ {
+		estimate = min(estimate, s.counts[s.cell(row, h1, h2)])
+	}
+	return estimate
+}
+
+// Merge добавляет в скетч частоты скетча other.
+// Скетчи должны иметь одинаковые размеры и хеш-функцию, иначе возвращается ErrIncompatible.
+func (s *CountMinSketch[T]) Merge(other *CountMinSketch[T]) error {
+	if s.width != other.width || s.depth != other.depth {
+		return ErrIncompatible
+	}
+	for i := range s.counts {
+		s.counts[i] += other.counts[i]
+	}
+	s.total += other.total
+	return nil
+}
+
+// Clear обнуляет все счетчики скетча.
+func (s *CountMinSketch[T]) Clear() {
+	clear(s.counts)
+	s.total = 0
+}
+
+// Copy возвращает копию скетча.
+func (s *CountMinSketch[T]) Copy() copiable.Copiable {
+	return &CountMinSketch[T]{
+		counts: slices.Clone(s.counts),
+		width:  s.width,
+		depth:  s.depth,
+		total:  s.total,
+		hasher: s.hasher,
+	}
+}
+
+// MarshalBinary сериализует скетч. Хеш-функция не сериализуется.
+func (s *CountMinSketch[T]) MarshalBinary() ([]byte, error) {
+	buf := appendHeader(kindCountMinSketch, 16+8*len(s.counts))
+	buf = binary.LittleEndian.AppendUint32(buf, s.width)
+	buf = binary.LittleEndian.AppendUint32(buf, s.depth)
+	buf = binary.LittleEndian.AppendUint64(buf, s.total)
+	for _, c := range s.counts {
+		buf = binary.LittleEndian.AppendUint64(buf, c)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary заменяет содержимое и размеры скетча сериализованными данными.
+// Хеш-функция скетча сохраняется и должна совпадать с хеш-функцией сериализованного скетча.
+func (s *CountMinSketch[T]) UnmarshalBinary(data []byte) error {
+	data, err := readHeader(data, kindCountMinSketch)
+	if err != nil {
+		return err
+	}
+	if len(data) < 16 {
+		return ErrInvalidData
+	}
+	width := binary.LittleEndian.Uint32(data)
+	depth := binary.LittleEndian.Uint32(data[4:])
+	total := binary.LittleEndian.Uint64(data[8:])
+	data = data[16:]
+	if width == 0 || depth == 0 || uint64(len(data)) != uint64(width)*uint64(depth)*8 {
+		return ErrInvalidData
+	}
+	counts := make([]uint64, len(data)/8)
+	for i := range counts {
+		counts[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	s.counts, s.width, s.depth, s.total = counts, width, depth, total
+	return nil
+}
@@ -0,0 +1,79 @@
+package probabilistic
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountMinSketchErrorBounds(t *testing.T) {
+	const (
+		epsilon = 0.001
+		delta   = 0.01
+	)
+	rnd := rand.New(rand.NewSource(1))
+	s := NewCountMinSketch(epsilon, delta, StableHasher[string]())
+	exact := make(map[string]uint64)
+	for _, item := range stream(rnd, 200000) {
+		s.Add(item, 1)
+		exact[item]++
+	}
+	require.Equal(t, uint64(200000), s.Total())
+
+	bound := uint64(epsilon * float64(s.Total()))
+	exceeded := 0
+	for item, count := range exact {
+		estimate := s.Estimate(item)
+		// Оценка никогда не бывает меньше истинной частоты.
+		require.GreaterOrEqual(t, estimate, count, item)
+		if estimate-count > bound {
+			exceeded++
+		}
+	}
+	assert.LessOrEqual(t, float64(exceeded)/float64(len(exact)), delta)
+	assert.Zero(t, NewCountMinSketch(epsilon, delta, StableHasher[string]()).Estimate("key-1"))
+}
+
+func TestCountMinSketchMarshalRoundTrip(t *testing.T) {
+	hasher := StableHasher[string]()
+	s := NewCountMinSketch(0.01, 0.01, hasher)
+	for i, key := range keys("k", 100) {
+		s.Add(key, uint64(i+1))
+	}
+	restored := NewCountMinSketch(0.5, 0.5, hasher)
+	checkRoundTrip(t, s, restored)
+	assert.Equal(t, s.Width(), restored.Width())
+	assert.Equal(t, s.Depth(), restored.Depth())
+	assert.Equal(t, s.Total(), restored.Total())
+	for _, key := range keys("k", 100) {
+		assert.Equal(t, s.Estimate(key), restored.Estimate(key))
+	}
+}
+
+func TestCountMinSketchUnmarshalInvalidData(t *testing.T) {
+	hasher := StableHasher[string]()
+	s := NewCountMinSketch(0.01, 0.01, hasher)
+	s.Add("a", 3)
+	target := NewCountMinSketch(0.1, 0.1, hasher)
+	target.Add("b", 1)
+	checkInvalidData(t, s, target)
+}
+
+func TestCountMinSketchMergeIncompatible(t *testing.T) {
+	hasher := StableHasher[string]()
+	s := NewCountMinSketch(0.01, 0.01, hasher)
+	s.Add("a", 1)
+	assert.ErrorIs(t, s.Merge(NewCountMinSketch(0.02, 0.01, hasher)), ErrIncompatible)
+	assert.ErrorIs(t, s.Merge(NewCountMinSketch(0.01, 0.0001, hasher)), ErrIncompatible)
+	assert.Equal(t, uint64(1), s.Estimate("a"))
+	assert.Equal(t, uint64(1), s.Total())
+}
+
+func TestCountMinSketchMergeAcrossWorkers(t *testing.T) {
+	checkMergeAcrossWorkers(t, stream(rand.New(rand.NewSource(1)), 20000),
+		func() *CountMinSketch[string] { return NewCountMinSketch(0.01, 0.01, StableHasher[string]()) },
+		func(s *CountMinSketch[string], item string) { s.Add(item, 1) },
+	)
+}
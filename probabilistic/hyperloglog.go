@@ -0,0 +1,137 @@
+package probabilistic
+
+import (
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/Delisa-sama/collections/associative/hashmap"
+	"github.com/Delisa-sama/collections/copiable"
+)
+
+const (
+	// MinPrecision - наименьшая точность HyperLogLog.
+	MinPrecision = 4
+	// MaxPrecision - наибольшая точность HyperLogLog.
+	MaxPrecision = 18
+)
+
+// HyperLogLog представляет собой оценщик количества различных элементов потока.
+// При точности p использует 2^p однобайтовых регистров, стандартная ошибка оценки - 1.04 / sqrt(2^p).
+type HyperLogLog[T any] struct {
+	registers []uint8
+	precision uint8
+	hasher    hashmap.Hasher[T]
+}
+
+// NewHyperLogLog создает оценщик с точностью precision.
+// Если precision не лежит в интервале [MinPrecision, MaxPrecision], возникает паника.
+func NewHyperLogLog[T any](precision uint8, hasher hashmap.Hasher[T]) *HyperLogLog[T] {
+	if precision < MinPrecision || precision > MaxPrecision {
+		panic("precision out of range")
+	}
+	return &HyperLogLog[T]{
+		registers: make([]uint8, 1<<precision),
+		precision: precision,
+		hasher:    hasher,
+	}
+}
+
+// Precision возвращает точность оценщика.
+func (h *HyperLogLog[T]) Precision() uint8 {
+	return h.precision
+}
+
+// Add добавляет элемент в оценщик.
+func (h *HyperLogLog[T]) Add(x T) {
+	hash, _ := hashes(h.hasher(x))
+	idx := hash >> (64 - h.precision)
+	// Сигнальный бит ограничивает длину серии нулей, если все оставшиеся биты нулевые.
+	w := hash<<h.precision | 1<<(h.precision-1)
+	h.registers[idx] = max(h.registers[idx], uint8(bits.LeadingZeros64(w)+1))
+}
+
+// Count возвращает оценку количества различных добавленных элементов.
+//
+//nolint:mnd // константы алгоритма HyperLogLog
+func (h *HyperLogLog[T]) Count() uint64 {
+	m := float64(len(h.registers))
+	var sum float64
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	estimate := alpha * m * m / sum
+	// Для малых мощностей точнее линейный подсчет по пустым регистрам.
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(math.Round(estimate))
+}
+
+// Merge добавляет в оценщик элементы оценщика other.
+// Оценщики должны иметь одинаковую точность и хеш-функцию, иначе возвращается ErrIncompatible.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.precision != other.precision {
+		return ErrIncompatible
+	}
+	for i := range h.registers {
+		h.registers[i] = max(h.registers[i], other.registers[i])
+	}
+	return nil
+}
+
+// Clear удаляет все элементы из оценщика.
+func (h *HyperLogLog[T]) Clear() {
+	clear(h.registers)
+}
+
+// Copy возвращает копию оценщика.
+func (h *HyperLogLog[T]) Copy() copiable.Copiable {
+	return &HyperLogLog[T]{
+		registers: slices.Clone(h.registers),
+		precision: h.precision,
+		hasher:    h.hasher,
+	}
+}
+
+// MarshalBinary сериализует оценщик. Хеш-функция не сериализуется.
+func (h *HyperLogLog[T]) MarshalBinary() ([]byte, error) {
+	buf := appendHeader(kindHyperLogLog, 1+len(h.registers))
+	buf = append(buf, h.precision)
+	return append(buf, h.registers...), nil
+}
+
+// UnmarshalBinary заменяет содержимое и точность оценщика сериализованными данными.
+// Хеш-функция оценщика сохраняется и должна совпадать с хеш-функцией сериализованного оценщика.
+func (h *HyperLogLog[T]) UnmarshalBinary(data []byte) error {
+	data, err := readHeader(data, kindHyperLogLog)
+	if err != nil {
+		return err
+	}
+	if len(data) < 1 {
+		return ErrInvalidData
+	}
+	precision := data[0]
+	data = data[1:]
+	if precision < MinPrecision || precision > MaxPrecision || len(data) != 1<<precision {
+		return ErrInvalidData
+	}
+	h.registers, h.precision = slices.Clone(data), precision
+	return nil
+}
@@ -0,0 +1,63 @@
+package probabilistic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHyperLogLogErrorBounds(t *testing.T) {
+	for _, precision := range []uint8{10, 14} {
+		standardError := 1.04 / math.Sqrt(float64(uint(1)<<precision))
+		for _, n := range []int{100, 10000, 200000} {
+			h := NewHyperLogLog(precision, StableHasher[string]())
+			for _, key := range keys("k", n) {
+				h.Add(key)
+				h.Add(key)
+			}
+			assert.InEpsilon(t, n, h.Count(), 3*standardError, "precision %d, n %d", precision, n)
+		}
+	}
+	assert.Zero(t, NewHyperLogLog(14, StableHasher[string]()).Count())
+}
+
+func TestHyperLogLogMarshalRoundTrip(t *testing.T) {
+	hasher := StableHasher[string]()
+	h := NewHyperLogLog(12, hasher)
+	for _, key := range keys("k", 5000) {
+		h.Add(key)
+	}
+	restored := NewHyperLogLog(MinPrecision, hasher)
+	checkRoundTrip(t, h, restored)
+	assert.Equal(t, h.Precision(), restored.Precision())
+	assert.Equal(t, h.Count(), restored.Count())
+}
+
+func TestHyperLogLogUnmarshalInvalidData(t *testing.T) {
+	hasher := StableHasher[string]()
+	h := NewHyperLogLog(10, hasher)
+	h.Add("a")
+	target := NewHyperLogLog(MinPrecision, hasher)
+	target.Add("b")
+	checkInvalidData(t, h, target)
+
+	data, _ := h.MarshalBinary()
+	data[headerSize] = MaxPrecision + 1
+	assert.ErrorIs(t, target.UnmarshalBinary(data), ErrInvalidData)
+}
+
+func TestHyperLogLogMergeIncompatible(t *testing.T) {
+	hasher := StableHasher[string]()
+	h := NewHyperLogLog(10, hasher)
+	h.Add("a")
+	assert.ErrorIs(t, h.Merge(NewHyperLogLog(11, hasher)), ErrIncompatible)
+	assert.Equal(t, uint64(1), h.Count())
+}
+
+func TestHyperLogLogMergeAcrossWorkers(t *testing.T) {
+	checkMergeAcrossWorkers(t, keys("k", 20000),
+		func() *HyperLogLog[string] { return NewHyperLogLog(12, StableHasher[string]()) },
+		func(h *HyperLogLog[string], item string) { h.Add(item) },
+	)
+}
@@ -0,0 +1,61 @@
+package probabilistic
+
+import (
+	"errors"
+	"hash/fnv"
+
+	"github.com/Delisa-sama/collections/associative/hashmap"
+)
+
+var (
+	// ErrInvalidData возвращается при разборе данных, не являющихся сериализованной структурой ожидаемого типа.
+	ErrInvalidData = errors.New("probabilistic: invalid binary data")
+	// ErrIncompatible возвращается при слиянии структур с различными параметрами.
+	ErrIncompatible = errors.New("probabilistic: incompatible parameters")
+)
+
+// version - версия формата сериализации.
+const version = 1
+
+// Типы сериализованных структур.
+const (
+	kindBloomFilter byte = iota + 1
+	kindCountMinSketch
+	kindHyperLogLog
+)
+
+// headerSize - размер заголовка сериализованной структуры: тип и версия формата.
+const headerSize = 2
+
+// appendHeader возвращает буфер с заголовком структуры типа kind и емкостью для size байт данных.
+func appendHeader(kind byte, size int) []byte {
+	buf := make([]byte, 0, headerSize+size)
+	return append(buf, kind, version)
+}
+
+// readHeader проверяет заголовок сериализованной структуры и возвращает данные после него.
+func readHeader(data []byte, kind byte) ([]byte, error) {
+	if len(data) < headerSize || data[0] != kind || data[1] != version {
+		return nil, ErrInvalidData
+	}
+	return data[headerSize:], nil
+}
+
+// hashes возвращает пару хешей для двойного хеширования (h1 + i*h2).
+// Второй хеш нечетный, поэтому последовательность не вырождается.
+func hashes(h uint64) (uint64, uint64) {
+	h1 := hashmap.Combine(h, 0)
+	h2 := hashmap.Combine(h1, h)
+	return h1, h2 | 1
+}
+
+// StableHasher возвращает хешер для строк и слайсов байт, не зависящий от процесса.
+// В отличие от hashmap.StringHasher, результат одинаков во всех процессах, поэтому
+// структуры, заполненные разными процессами, можно сливать.
+func StableHasher[K ~string | ~[]byte]() hashmap.Hasher[K] {
+	return func(key K) uint64 {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key))
+		return hashmap.Combine(h.Sum64(), 0)
+	}
+}
@@ -0,0 +1,147 @@
+package probabilistic
+
+import (
+	"encoding"
+	"math/rand"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sketch - общий интерфейс структур пакета, используемый в тестах сериализации и слияния.
+type sketch[S any] interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	Merge(other S) error
+}
+
+// keys возвращает n различных строковых ключей с заданным префиксом.
+func keys(prefix string, n int) []string {
+	result := make([]string, n)
+	for i := range result {
+		result[i] = prefix + strconv.Itoa(i)
+	}
+	return result
+}
+
+// stream возвращает поток из n ключей с неравномерными частотами: ключ с номером i встречается
+// примерно в 1/(i+1) раз реже самого частого.
+func stream(rnd *rand.Rand, n int) []string {
+	zipf := rand.NewZipf(rnd, 1.1, 1, 10000)
+	result := make([]string, n)
+	for i := range result {
+		result[i] = "key-" + strconv.FormatUint(zipf.Uint64(), 10)
+	}
+	return result
+}
+
+// checkRoundTrip проверяет, что структура после сериализации и разбора сериализуется в те же байты.
+func checkRoundTrip[S sketch[S]](t *testing.T, original, restored S) {
+	t.Helper()
+	data, err := original.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, restored.UnmarshalBinary(data))
+	restoredData, err := restored.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, data, restoredData)
+}
+
+// checkInvalidData проверяет, что усеченные и искаженные данные отклоняются с ErrInvalidData,
+// а структура при этом не изменяется.
+func checkInvalidData[S sketch[S]](t *testing.T, original, target S) {
+	t.Helper()
+	data, err := original.MarshalBinary()
+	require.NoError(t, err)
+	before, err := target.MarshalBinary()
+	require.NoError(t, err)
+
+	invalid := [][]byte{
+		nil,
+		data[:1],
+		data[:headerSize],
+		data[:headerSize+1],
+		data[:len(data)-1],
+		append(append([]byte(nil), data...), 0),
+	}
+	wrongVersion := append([]byte(nil), data...)
+	wrongVersion[1] = version + 1
+	invalid = append(invalid, wrongVersion)
+	for _, d := range invalid {
+		assert.ErrorIs(t, target.UnmarshalBinary(d), ErrInvalidData, "length %d", len(d))
+	}
+
+	after, err := target.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+}
+
+// checkMergeAcrossWorkers заполняет по структуре на каждого обработчика своей частью потока,
+// передает их в сериализованном виде и сливает. Результат должен совпадать со структурой,
+// заполненной всем потоком.
+func checkMergeAcrossWorkers[S sketch[S]](
+	t *testing.T,
+	items []string,
+	newSketch func() S,
+	add func(s S, item string),
+) {
+	t.Helper()
+	const workers = 4
+
+	whole := newSketch()
+	for _, item := range items {
+		add(whole, item)
+	}
+
+	parts := make([][]byte, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s := newSketch()
+			for i := w; i < len(items); i += workers {
+				add(s, items[i])
+			}
+			parts[w], _ = s.MarshalBinary()
+		}()
+	}
+	wg.Wait()
+
+	merged := newSketch()
+	for _, part := range parts {
+		s := newSketch()
+		require.NoError(t, s.UnmarshalBinary(part))
+		require.NoError(t, merged.Merge(s))
+	}
+
+	expected, err := whole.MarshalBinary()
+	require.NoError(t, err)
+	actual, err := merged.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnmarshalWrongKind(t *testing.T) {
+	hasher := StableHasher[string]()
+	bloom, _ := NewBloomFilter(100, 0.01, hasher).MarshalBinary()
+	cms, _ := NewCountMinSketch(0.01, 0.01, hasher).MarshalBinary()
+	hll, _ := NewHyperLogLog(MinPrecision, hasher).MarshalBinary()
+
+	assert.ErrorIs(t, NewBloomFilter(100, 0.01, hasher).UnmarshalBinary(cms), ErrInvalidData)
+	assert.ErrorIs(t, NewBloomFilter(100, 0.01, hasher).UnmarshalBinary(hll), ErrInvalidData)
+	assert.ErrorIs(t, NewCountMinSketch(0.01, 0.01, hasher).UnmarshalBinary(bloom), ErrInvalidData)
+	assert.ErrorIs(t, NewCountMinSketch(0.01, 0.01, hasher).UnmarshalBinary(hll), ErrInvalidData)
+	assert.ErrorIs(t, NewHyperLogLog(MinPrecision, hasher).UnmarshalBinary(bloom), ErrInvalidData)
+	assert.ErrorIs(t, NewHyperLogLog(MinPrecision, hasher).UnmarshalBinary(cms), ErrInvalidData)
+}
+
+func TestStableHasher(t *testing.T) {
+	strings := StableHasher[string]()
+	bytes := StableHasher[[]byte]()
+	assert.Equal(t, strings("abc"), strings("abc"))
+	assert.Equal(t, strings("abc"), bytes([]byte("abc")))
+	assert.NotEqual(t, strings("abc"), strings("abd"))
+}
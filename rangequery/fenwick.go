@@ -0,0 +1,132 @@
+package rangequery
+
+import (
+	"slices"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+)
+
+// FenwickTree представляет собой дерево Фенвика (двоичное индексированное дерево) над числовым массивом.
+// Поддерживает прибавление к элементу и к диапазону, а также сумму диапазона за O(log n).
+//
+// Хранятся два дерева: сумма префикса [0, n) равна slope(n) * n + offset(n),
+// где slope и offset - префиксные суммы соответствующих деревьев.
+// Прибавление к отдельному элементу изменяет только offset.
+type FenwickTree[T interfaces.Numeric] struct {
+	slope  []T // Индексация с единицы, нулевой элемент не используется.
+	offset []T
+}
+
+// NewFenwickTree создает дерево Фенвика над массивом из size нулей.
+func NewFenwickTree[T interfaces.Numeric](size uint) *FenwickTree[T] {
+	return &FenwickTree[T]{
+		slope:  make([]T, size+1),
+		offset: make([]T, size+1),
+	}
+}
+
+// NewFenwickTreeFromRange создает дерево Фенвика над значениями диапазона [begin, end) за O(n).
+func NewFenwickTreeFromRange[T interfaces.Numeric](
+	begin interfaces.RandomAccessIterator[T],
+	end interfaces.RandomAccessIterator[T],
+) *FenwickTree[T] {
+	values := rangeValues(begin, end)
+	t := NewFenwickTree[T](uint(len(values)))
+	copy(t.offset[1:], values)
+	// Каждый узел передает накопленную сумму ближайшему родителю.
+	for i := 1; i < len(t.offset); i++ {
+		if parent := i + i&-i; parent < len(t.offset) {
+			t.offset[parent] += t.offset[i]
+		}
+	}
+	return t
+}
+
+// add прибавляет delta к элементу i (индексация с единицы) дерева tree.
+func add[T interfaces.Numeric](tree []T, i uint, delta T) {
+	for ; i < uint(len(tree)); i += i & -i {
+		tree[i] += delta
+	}
+}
+
+// prefix возвращает сумму первых n элементов дерева tree.
+func prefix[T interfaces.Numeric](tree []T, n uint) T {
+	var sum T
+	for ; n > 0; n -= n & -n {
+		sum += tree[n]
+	}
+	return sum
+}
+
+// scale возвращает x, сложенный с собой n раз, за O(log n) сложений.
+// Преобразование целого числа в комплексный тип недопустимо, поэтому умножение выражено через сложение.
+func scale[T interfaces.Numeric](x T, n uint) T {
+	var res T
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			res += x
+		}
+		x += x
+	}
+	return res
+}
+
+// Size возвращает количество элементов массива.
+func (t *FenwickTree[T]) Size() uint {
+	return uint(len(t.offset) - 1)
+}
+
+// IsEmpty проверяет что массив пустой.
+func (t *FenwickTree[T]) IsEmpty() bool {
+	return t.Size() == 0
+}
+
+// Add прибавляет delta к элементу с индексом index.
+func (t *FenwickTree[T]) Add(index uint, delta T) {
+	checkIndex(index, t.Size())
+	add(t.offset, index+1, delta)
+}
+
+// AddRange прибавляет delta к каждому элементу диапазона [from, to).
+func (t *FenwickTree[T]) AddRange(from, to uint, delta T) {
+	checkRange(from, to, t.Size())
+	if from == to {
+		return
+	}
+	add(t.slope, from+1, delta)
+	add(t.slope, to+1, -delta)
+	add(t.offset, from+1, -scale(delta, from))
+	add(t.offset, to+1, scale(delta, to))
+}
+
+// PrefixSum возвращает сумму первых n элементов.
+func (t *FenwickTree[T]) PrefixSum(n uint) T {
+	checkRange(0, n, t.Size())
+	return scale(prefix(t.slope, n), n) + prefix(t.offset, n)
+}
+
+// Sum возвращает сумму элементов диапазона [from, to).
+func (t *FenwickTree[T]) Sum(from, to uint) T {
+	checkRange(from, to, t.Size())
+	return t.PrefixSum(to) - t.PrefixSum(from)
+}
+
+// At возвращает элемент с индексом index.
+func (t *FenwickTree[T]) At(index uint) T {
+	checkIndex(index, t.Size())
+	return t.Sum(index, index+1)
+}
+
+// Set заменяет элемент с индексом index на value.
+func (t *FenwickTree[T]) Set(index uint, value T) {
+	t.Add(index, value-t.At(index))
+}
+
+// Copy возвращает копию дерева.
+func (t *FenwickTree[T]) Copy() copiable.Copiable {
+	return &FenwickTree[T]{
+		slope:  slices.Clone(t.slope),
+		offset: slices.Clone(t.offset),
+	}
+}
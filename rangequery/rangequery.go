@@ -0,0 +1,31 @@
+package rangequery
+
+import "github.com/Delisa-sama/collections/interfaces"
+
+// rangeValues копирует значения диапазона [begin, end) в новый слайс.
+func rangeValues[T any](begin, end interfaces.RandomAccessIterator[T]) []T {
+	n := end.Index() - begin.Index()
+	values := make([]T, n)
+	for i := range values {
+		v, ok := begin.At(begin.Index() + uint(i))
+		if !ok {
+			panic("range is out of bounds")
+		}
+		values[i] = *v
+	}
+	return values
+}
+
+// checkRange проверяет, что диапазон [from, to) лежит в пределах [0, size).
+func checkRange(from, to, size uint) {
+	if from > to || to > size {
+		panic("range out of bounds")
+	}
+}
+
+// checkIndex проверяет, что индекс лежит в пределах [0, size).
+func checkIndex(index, size uint) {
+	if index >= size {
+		panic("index out of range")
+	}
+}
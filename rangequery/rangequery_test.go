@@ -0,0 +1,139 @@
+package rangequery
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Delisa-sama/collections/sequence/vector"
+)
+
+// sizes - размеры массивов для проверки: пустой, из одного элемента, степени двойки и размеры между ними.
+var sizes = []uint{0, 1, 2, 3, 7, 8, 37, 64}
+
+// randomRange возвращает случайный диапазон [from, to) в пределах [0, size], в том числе пустой.
+func randomRange(rnd *rand.Rand, size uint) (uint, uint) {
+	from := uint(rnd.Intn(int(size) + 1))
+	to := uint(rnd.Intn(int(size) + 1))
+	if from > to {
+		from, to = to, from
+	}
+	return from, to
+}
+
+func TestFenwickTreeRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, size := range sizes {
+		model := make([]int, size)
+		for i := range model {
+			model[i] = rnd.Intn(200) - 100
+		}
+		values := vector.NewVector(model...)
+		tree := NewFenwickTreeFromRange(values.Begin(), values.End())
+		require.Equal(t, size, tree.Size())
+		require.Equal(t, size == 0, tree.IsEmpty())
+
+		for step := 0; step < 1000; step++ {
+			from, to := randomRange(rnd, size)
+			switch op := rnd.Intn(4); {
+			case op == 0 && size > 0:
+				index := uint(rnd.Intn(int(size)))
+				delta := rnd.Intn(200) - 100
+				tree.Add(index, delta)
+				model[index] += delta
+			case op == 1 && size > 0:
+				index := uint(rnd.Intn(int(size)))
+				value := rnd.Intn(200) - 100
+				tree.Set(index, value)
+				model[index] = value
+			default:
+				delta := rnd.Intn(200) - 100
+				tree.AddRange(from, to, delta)
+				for i := from; i < to; i++ {
+					model[i] += delta
+				}
+			}
+
+			from, to = randomRange(rnd, size)
+			expected := 0
+			for i := from; i < to; i++ {
+				expected += model[i]
+			}
+			require.Equal(t, expected, tree.Sum(from, to), "size %d, [%d, %d)", size, from, to)
+		}
+
+		prefix := 0
+		for n := uint(0); n <= size; n++ {
+			require.Equal(t, prefix, tree.PrefixSum(n))
+			if n < size {
+				require.Equal(t, model[n], tree.At(n))
+				prefix += model[n]
+			}
+		}
+		assert.Panics(t, func() { tree.PrefixSum(size + 1) })
+		assert.Panics(t, func() { tree.At(size) })
+	}
+}
+
+func TestSegmentTreeRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	letters := []string{"a", "b", "c", "d"}
+	concat := func(a, b string) string { return a + b }
+
+	for _, size := range sizes {
+		model := make([]string, size)
+		for i := range model {
+			model[i] = letters[rnd.Intn(len(letters))]
+		}
+		// Конкатенация не коммутативна, поэтому проверяется и порядок объединения.
+		values := vector.NewVector(model...)
+		tree := NewSegmentTreeFromRange(concat, "", values.Begin(), values.End())
+		require.Equal(t, size, tree.Size())
+
+		for step := 0; step < 1000; step++ {
+			switch rnd.Intn(3) {
+			case 0:
+				if size > 0 {
+					index := uint(rnd.Intn(int(size)))
+					value := letters[rnd.Intn(len(letters))]
+					tree.Set(index, value)
+					model[index] = value
+				}
+			default:
+				from, to := randomRange(rnd, size)
+				value := letters[rnd.Intn(len(letters))]
+				tree.AssignRange(from, to, value)
+				for i := from; i < to; i++ {
+					model[i] = value
+				}
+			}
+
+			from, to := randomRange(rnd, size)
+			require.Equal(t, strings.Join(model[from:to], ""), tree.Query(from, to), "size %d, [%d, %d)", size, from, to)
+			require.Equal(t, strings.Join(model, ""), tree.All())
+		}
+
+		for i := uint(0); i < size; i++ {
+			require.Equal(t, model[i], tree.At(i))
+		}
+		assert.Panics(t, func() { tree.Query(0, size+1) })
+		assert.Panics(t, func() { tree.At(size) })
+	}
+}
+
+func TestSegmentTreeCopyIsIndependent(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+	tree := NewSegmentTree(37, sum, 0)
+	tree.AssignRange(0, 37, 1)
+	cpy := tree.Copy().(*SegmentTree[int])
+	cpy.AssignRange(10, 20, 5)
+	tree.Set(15, 100)
+
+	assert.Equal(t, 37-1+100, tree.All())
+	assert.Equal(t, 37+10*4, cpy.All())
+	assert.Equal(t, 5, cpy.At(15))
+	assert.Equal(t, 1, tree.At(14))
+}
@@ -0,0 +1,204 @@
+package rangequery
+
+import (
+	"math/bits"
+	"slices"
+
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+)
+
+// assignment - отложенное присваивание значения всем элементам поддерева.
+// powers[h] - результат объединения 2^h копий значения, то есть значение узла высоты h.
+type assignment[T any] struct {
+	powers []T
+}
+
+// SegmentTree представляет собой дерево отрезков над моноидом, заданным ассоциативной функцией объединения
+// и нейтральным элементом. Поддерживает присваивание элементу и диапазону и запрос объединения диапазона за O(log n).
+// Присваивание диапазону распространяется по дереву отложенно.
+// Коммутативность функции объединения не требуется: элементы объединяются слева направо.
+type SegmentTree[T any] struct {
+	tree     []T              // Двоичная куча с индексацией с единицы, листья начинаются с индекса capacity.
+	lazy     []*assignment[T] // Отложенные присваивания внутренних узлов.
+	size     uint
+	capacity uint // Количество листьев - наименьшая степень двойки, не меньшая size.
+	height   uint // Высота корня, высота листьев равна нулю.
+	combine  func(a, b T) T
+	identity T
+}
+
+// NewSegmentTree создает дерево отрезков над массивом из size нейтральных элементов.
+func NewSegmentTree[T any](size uint, combine func(a, b T) T, identity T) *SegmentTree[T] {
+	t := newSegmentTree(size, combine, identity)
+	for i := range t.tree {
+		t.tree[i] = identity
+	}
+	return t
+}
+
+// NewSegmentTreeFromRange создает дерево отрезков над значениями диапазона [begin, end) за O(n).
+func NewSegmentTreeFromRange[T any](
+	combine func(a, b T) T,
+	identity T,
+	begin interfaces.RandomAccessIterator[T],
+	end interfaces.RandomAccessIterator[T],
+) *SegmentTree[T] {
+	values := rangeValues(begin, end)
+	t := newSegmentTree(uint(len(values)), combine, identity)
+	leaves := t.tree[t.capacity:]
+	copy(leaves, values)
+	for i := len(values); i < len(leaves); i++ {
+		leaves[i] = identity
+	}
+	for i := t.capacity - 1; i > 0; i-- {
+		t.pull(i)
+	}
+	return t
+}
+
+// newSegmentTree выделяет память под дерево, не заполняя узлы.
+func newSegmentTree[T any](size uint, combine func(a, b T) T, identity T) *SegmentTree[T] {
+	height := uint(0)
+	if size > 1 {
+		height = uint(bits.Len(size - 1))
+	}
+	capacity := uint(1) << height
+	return &SegmentTree[T]{
+		tree:     make([]T, 2*capacity),
+		lazy:     make([]*assignment[T], capacity),
+		size:     size,
+		capacity: capacity,
+		height:   height,
+		combine:  combine,
+		identity: identity,
+	}
+}
+
+// Size возвращает количество элементов массива.
+func (t *SegmentTree[T]) Size() uint {
+	return t.size
+}
+
+// IsEmpty проверяет что массив пустой.
+func (t *SegmentTree[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// pull пересчитывает значение внутреннего узла по его потомкам.
+func (t *SegmentTree[T]) pull(node uint) {
+	t.tree[node] = t.combine(t.tree[2*node], t.tree[2*node+1])
+}
+
+// apply присваивает значение всем элементам поддерева узла node высоты h.
+func (t *SegmentTree[T]) apply(node, h uint, a *assignment[T]) {
+	t.tree[node] = a.powers[h]
+	if node < t.capacity {
+		t.lazy[node] = a
+	}
+}
+
+// push передает отложенное присваивание узла node высоты h его потомкам.
+func (t *SegmentTree[T]) push(node, h uint) {
+	if a := t.lazy[node]; a != nil {
+		t.apply(2*node, h-1, a)
+		t.apply(2*node+1, h-1, a)
+		t.lazy[node] = nil
+	}
+}
+
+// assign присваивает значение элементам диапазона [from, to) в поддереве узла node высоты h,
+// покрывающем элементы [lo, hi).
+func (t *SegmentTree[T]) assign(node, h, lo, hi, from, to uint, a *assignment[T]) {
+	if to <= lo || hi <= from {
+		return
+	}
+	if from <= lo && hi <= to {
+		t.apply(node, h, a)
+		return
+	}
+	t.push(node, h)
+	mid := lo + (hi-lo)/2
+	t.assign(2*node, h-1, lo, mid, from, to, a)
+	t.assign(2*node+1, h-1, mid, hi, from, to, a)
+	t.pull(node)
+}
+
+// query возвращает объединение элементов диапазона [from, to) в поддереве узла node высоты h,
+// покрывающем элементы [lo, hi).
+func (t *SegmentTree[T]) query(node, h, lo, hi, from, to uint) T {
+	if to <= lo || hi <= from {
+		return t.identity
+	}
+	if from <= lo && hi <= to {
+		return t.tree[node]
+	}
+	t.push(node, h)
+	mid := lo + (hi-lo)/2
+	return t.combine(
+		t.query(2*node, h-1, lo, mid, from, to),
+		t.query(2*node+1, h-1, mid, hi, from, to),
+	)
+}
+
+// AssignRange присваивает value каждому элементу диапазона [from, to).
+func (t *SegmentTree[T]) AssignRange(from, to uint, value T) {
+	checkRange(from, to, t.size)
+	if from == to {
+		return
+	}
+	a := &assignment[T]{powers: make([]T, t.height+1)}
+	a.powers[0] = value
+	for h := uint(1); h <= t.height; h++ {
+		a.powers[h] = t.combine(a.powers[h-1], a.powers[h-1])
+	}
+	t.assign(1, t.height, 0, t.capacity, from, to, a)
+}
+
+// Set заменяет элемент с индексом index на value.
+func (t *SegmentTree[T]) Set(index uint, value T) {
+	checkIndex(index, t.size)
+	node := t.capacity + index
+	// Отложенные присваивания на пути от корня должны быть переданы вниз до изменения листа.
+	for h := t.height; h > 0; h-- {
+		t.push(node>>h, h)
+	}
+	t.tree[node] = value
+	for node >>= 1; node > 0; node >>= 1 {
+		t.pull(node)
+	}
+}
+
+// Query возвращает объединение элементов диапазона [from, to).
+// Для пустого диапазона возвращает нейтральный элемент.
+func (t *SegmentTree[T]) Query(from, to uint) T {
+	checkRange(from, to, t.size)
+	if from == to {
+		return t.identity
+	}
+	return t.query(1, t.height, 0, t.capacity, from, to)
+}
+
+// All возвращает объединение всех элементов массива.
+func (t *SegmentTree[T]) All() T {
+	return t.tree[1]
+}
+
+// At возвращает элемент с индексом index.
+func (t *SegmentTree[T]) At(index uint) T {
+	checkIndex(index, t.size)
+	return t.query(1, t.height, 0, t.capacity, index, index+1)
+}
+
+// Copy возвращает копию дерева. Отложенные присваивания разделяются между копиями, так как не изменяются.
+func (t *SegmentTree[T]) Copy() copiable.Copiable {
+	return &SegmentTree[T]{
+		tree:     slices.Clone(t.tree),
+		lazy:     slices.Clone(t.lazy),
+		size:     t.size,
+		capacity: t.capacity,
+		height:   t.height,
+		combine:  t.combine,
+		identity: t.identity,
+	}
+}
@@ -132,22 +132,20 @@ func (d *Deque[T]) PushBack(value T) {
 // Back возвращает последний элемент деки без его удаления.
 func (d *Deque[T]) Back() T {
 	lastChunk := d.chunks[len(d.chunks)-1]
-	return lastChunk.buf[lastChunk.end]
+	return lastChunk.buf[lastChunk.end-1]
 }
 
 // PopBack удаляет последний элемент из деки.
+// Опустевший чанк удаляется сразу, поэтому дека не содержит пустых чанков.
 func (d *Deque[T]) PopBack() {
-	if len(d.chunks) == 0 {
+	if d.size == 0 {
 		return
 	}
 
 	lastChunk := d.chunks[len(d.chunks)-1]
-	if !lastChunk.popBack() {
+	lastChunk.popBack()
+	if lastChunk.begin == lastChunk.end {
 		d.chunks = d.chunks[:len(d.chunks)-1]
-		if d.size == 0 {
-			return
-		}
-		d.chunks[len(d.chunks)-1].popBack()
 	}
 	d.size--
 }
@@ -167,24 +165,20 @@ func (d *Deque[T]) PushFront(value T) {
 
 // Front возвращает первый элемент деки без его удаления.
 func (d *Deque[T]) Front() T {
-	firstChunkIdx := 0
-	if d.chunks[0].end-d.chunks[0].begin == 0 {
-		firstChunkIdx = 1
-	}
-	return d.chunks[firstChunkIdx].buf[d.chunks[firstChunkIdx].begin]
+	return d.chunks[0].buf[d.chunks[0].begin]
 }
 
 // PopFront удаляет первый элемент из деки.
+// Опустевший чанк удаляется сразу, поэтому дека не содержит пустых чанков.
 func (d *Deque[T]) PopFront() {
-	if len(d.chunks) == 0 {
+	if d.size == 0 {
 		return
 	}
-	if !d.chunks[0].popFront() {
+
+	firstChunk := d.chunks[0]
+	firstChunk.popFront()
+	if firstChunk.begin == firstChunk.end {
 		d.chunks = d.chunks[1:]
-		if d.size == 0 {
-			return
-		}
-		d.chunks[0].popFront()
 	}
 	d.size--
 }
@@ -197,9 +191,6 @@ func (d *Deque[T]) At(index uint) T {
 // AtPtr возвращает указатель на элемент на указанной позиции в деке.
 func (d *Deque[T]) AtPtr(index uint) *T {
 	firstChunkSize := d.chunks[0].end - d.chunks[0].begin
-	if firstChunkSize == 0 {
-		firstChunkSize = 1
-	}
 	if index < firstChunkSize {
 		return &d.chunks[0].buf[d.chunks[0].begin+index]
 	}
@@ -221,21 +212,16 @@ func (d *Deque[T]) IsEmpty() bool {
 }
 
 // Copy создает и возвращает копию текущей деки.
+// Копируются только заполненные области чанков, поэтому копия не разделяет память с исходной декой.
 func (d *Deque[T]) Copy() copiable.Copiable {
 	cpy := NewDeque[T]()
-	if d.size == 0 {
-		return cpy
-	}
 	cpy.chunks = make([]*chunk[T], 0, len(d.chunks))
 	for _, c := range d.chunks {
-		for i := range c.buf {
-			if i == 0 {
-				cpy.chunks = append(cpy.chunks, newChunk(c.buf[i]))
-				continue
-			}
-			cpy.chunks[len(cpy.chunks)-1].buf[i] = c.buf[i]
-		}
+		chunkCopy := &chunk[T]{begin: c.begin, end: c.end}
+		copy(chunkCopy.buf[c.begin:c.end], c.buf[c.begin:c.end])
+		cpy.chunks = append(cpy.chunks, chunkCopy)
 	}
+	cpy.size = d.size
 	return cpy
 }
 
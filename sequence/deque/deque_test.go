@@ -0,0 +1,88 @@
+package deque
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Delisa-sama/collections/copiable"
+)
+
+func TestBackAfterPushBack(t *testing.T) {
+	d := NewDeque[int]()
+	d.PushBack(1)
+	assert.Equal(t, 1, d.Back())
+	d.PushBack(2)
+	assert.Equal(t, 2, d.Back())
+	assert.Equal(t, 1, d.Front())
+}
+
+func TestAtAfterPopFrontAcrossChunks(t *testing.T) {
+	items := make([]int, 2*chunkBufferSize+3)
+	for i := range items {
+		items[i] = i
+	}
+	d := NewDeque(items...)
+
+	// Проверяем доступ по индексу после каждого удаления, в том числе когда первый чанк опустел.
+	for popped := 1; popped <= chunkBufferSize+1; popped++ {
+		d.PopFront()
+		assert.Equal(t, uint(len(items)-popped), d.Size())
+		assert.Equal(t, popped, d.Front())
+		for i := uint(0); i < d.Size(); i++ {
+			assert.Equal(t, int(i)+popped, d.At(i))
+		}
+	}
+}
+
+func TestPopBackAcrossChunks(t *testing.T) {
+	items := make([]int, chunkBufferSize+2)
+	for i := range items {
+		items[i] = i
+	}
+	d := NewDeque(items...)
+
+	d.PopBack()
+	d.PopBack()
+	assert.Equal(t, chunkBufferSize-1, d.Back())
+	d.PopBack()
+	assert.Equal(t, chunkBufferSize-2, d.Back())
+	assert.Equal(t, uint(chunkBufferSize-1), d.Size())
+
+	for d.Size() > 0 {
+		d.PopBack()
+	}
+	assert.True(t, d.IsEmpty())
+	d.PushFront(42)
+	assert.Equal(t, 42, d.Front())
+	assert.Equal(t, 42, d.Back())
+	assert.Equal(t, 42, d.At(0))
+}
+
+func TestCopy(t *testing.T) {
+	items := make([]int, 2*chunkBufferSize+5)
+	for i := range items {
+		items[i] = i
+	}
+	d := NewDeque(items...)
+	d.PopFront()
+	d.PushFront(-1)
+	d.PushFront(-2)
+	d.PopBack()
+
+	c := copiable.Copy[*Deque[int]](d)
+	assert.Equal(t, d.Size(), c.Size())
+	for i := uint(0); i < d.Size(); i++ {
+		assert.Equal(t, d.At(i), c.At(i))
+	}
+
+	// Изменения копии не затрагивают исходную деку.
+	c.PushBack(100)
+	*c.AtPtr(0) = 42
+	assert.Equal(t, uint(len(items)), d.Size())
+	assert.Equal(t, -2, d.Front())
+	assert.Equal(t, len(items)-2, d.Back())
+	assert.Equal(t, 100, c.Back())
+
+	assert.True(t, copiable.Copy[*Deque[int]](NewDeque[int]()).IsEmpty())
+}
@@ -186,3 +186,62 @@ func (l *List[T]) Erase(begin, end interfaces.Iterator) {
 	}
 	l.size -= removedSize
 }
+
+// InsertBefore вставляет значение перед элементом, на который указывает pos.
+// Если pos указывает на конец списка, значение добавляется в конец.
+// Возвращает итератор на вставленный элемент. Вставка не инвалидирует итераторы.
+func (l *List[T]) InsertBefore(pos interfaces.Iterator, value T) interfaces.BidirectionalIterator[T] {
+	var next *node[T]
+	switch p := pos.(type) {
+	case *iterator[T]:
+		next = p.current
+	case *iterators.EndIterator:
+	default:
+		panic("unknown iterator type")
+	}
+
+	if next == nil {
+		l.PushBack(value)
+		return newIterator(l.tail)
+	}
+	if next == l.head {
+		l.PushFront(value)
+		return newIterator(l.head)
+	}
+
+	newNode := &node[T]{
+		Value: &value,
+		Next:  next,
+		Prev:  next.Prev,
+	}
+	next.Prev.Next = newNode
+	next.Prev = newNode
+	l.size++
+	return newIterator(newNode)
+}
+
+// MoveToFront перемещает элемент, на который указывает pos, в начало списка.
+// Элемент не копируется, поэтому итераторы на него остаются действительными.
+func (l *List[T]) MoveToFront(pos interfaces.Iterator) {
+	p, ok := pos.(*iterator[T])
+	if !ok {
+		panic("unknown iterator type")
+	}
+	n := p.current
+	if n == l.head {
+		return
+	}
+
+	// Узел не первый, поэтому у него есть предыдущий.
+	n.Prev.Next = n.Next
+	if n.Next != nil {
+		n.Next.Prev = n.Prev
+	} else {
+		l.tail = n.Prev
+	}
+
+	n.Prev = nil
+	n.Next = l.head
+	l.head.Prev = n
+	l.head = n
+}
@@ -0,0 +1,107 @@
+package persistentvector
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// iterator представляет собой итератор с произвольным доступом по версии персистентного вектора.
+// Версии неизменяемы, поэтому итератор остается действительным при создании новых версий.
+type iterator[T any] struct {
+	v       *PersistentVector[T]
+	current uint
+}
+
+// newIterator создает новый итератор.
+func newIterator[T any](v *PersistentVector[T], index uint) *iterator[T] {
+	return &iterator[T]{
+		v:       v,
+		current: index,
+	}
+}
+
+// HasNext проверяет, есть ли следующий элемент.
+func (it *iterator[T]) HasNext() bool {
+	return it.indexInBounds(it.current + 1)
+}
+
+// Next переходит к следующему элементу.
+func (it *iterator[T]) Next() {
+	it.current++
+}
+
+// HasPrev проверяет, есть ли предыдущий элемент.
+func (it *iterator[T]) HasPrev() bool {
+	return it.indexInBounds(it.current - 1)
+}
+
+// Prev переходит к предыдущему элементу.
+func (it *iterator[T]) Prev() {
+	it.current--
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator[T]) Value() T {
+	return it.v.At(it.current)
+}
+
+// Ptr возвращает указатель на копию текущего значения итератора.
+// Элементы разделяются версиями вектора, поэтому изменение через указатель не затрагивает вектор.
+func (it *iterator[T]) Ptr() *T {
+	value := it.Value()
+	return &value
+}
+
+// At возвращает указатель на копию элемента по заданному индексу.
+func (it *iterator[T]) At(index uint) (*T, bool) {
+	if !it.indexInBounds(index) {
+		return nil, false
+	}
+	value := it.v.At(index)
+	return &value, true
+}
+
+// Shift смещает итератор на заданное количество элементов.
+// Если смещение положительное - смещает вперед, если отрицательное - назад.
+func (it *iterator[T]) Shift(offset int) {
+	if offset < 0 {
+		it.current -= uint(0 - offset)
+	} else {
+		it.current += uint(offset)
+	}
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+func (it *iterator[T]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[T]:
+		if a.isEnd() || it.isEnd() {
+			return a.isEnd() && it.isEnd()
+		}
+		return it.v == a.v && it.current == a.current
+	case *iterators.EndIterator:
+		return it.isEnd()
+	}
+	panic("unknown iterator type")
+}
+
+func (it *iterator[T]) indexInBounds(index uint) bool {
+	return index < it.v.size
+}
+
+// isEnd проверяет, что итератор находится за пределами вектора.
+// Позиция перед первым элементом, в которую попадает перевернутый итератор, также считается концом.
+func (it *iterator[T]) isEnd() bool {
+	return it.current >= it.v.size
+}
+
+// Copy копирует итератор.
+func (it *iterator[T]) Copy() copiable.Copiable {
+	return newIterator(it.v, it.current)
+}
+
+// Index возвращает текущий индекс итератора.
+func (it *iterator[T]) Index() uint {
+	return it.current
+}
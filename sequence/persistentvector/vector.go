@@ -0,0 +1,296 @@
+package persistentvector
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+const (
+	// bitsPerLevel - количество бит индекса, определяющих позицию на одном уровне дерева.
+	bitsPerLevel = 5
+	// branching - количество потомков узла и размер листа.
+	branching = 1 << bitsPerLevel
+	// levelMask - маска для выделения позиции на уровне.
+	levelMask = branching - 1
+)
+
+// node представляет собой узел дерева. Внутренние узлы хранят потомков, листья - значения.
+type node[T any] struct {
+	children []*node[T]
+	values   []T
+}
+
+// PersistentVector представляет собой неизменяемый (персистентный) вектор
+// на основе 32-ичного префиксного дерева с буфером хвоста.
+// Операции изменения возвращают новую версию вектора, разделяющую неизмененные узлы с исходной.
+// Последние до 32 элементов хранятся в хвосте, поэтому добавление и удаление с конца в основном выполняются за O(1).
+type PersistentVector[T any] struct {
+	size  uint
+	shift uint // Сдвиг индекса для корня дерева.
+	root  *node[T]
+	tail  []T
+}
+
+// NewPersistentVector создает новый вектор и заполняет его переданными элементами.
+// Дерево строится снизу вверх без промежуточных версий.
+func NewPersistentVector[T any](items ...T) *PersistentVector[T] {
+	v := &PersistentVector[T]{
+		size:  uint(len(items)),
+		shift: bitsPerLevel,
+		root:  &node[T]{},
+	}
+	offset := v.tailOffset()
+	v.tail = make([]T, len(items)-int(offset), branching)
+	copy(v.tail, items[offset:])
+	if offset == 0 {
+		return v
+	}
+
+	nodes := make([]*node[T], 0, offset/branching)
+	for i := uint(0); i < offset; i += branching {
+		values := make([]T, branching)
+		copy(values, items[i:i+branching])
+		nodes = append(nodes, &node[T]{values: values})
+	}
+	for len(nodes) > branching {
+		parents := make([]*node[T], 0, (len(nodes)+branching-1)/branching)
+		for i := 0; i < len(nodes); i += branching {
+			parents = append(parents, &node[T]{children: nodes[i:min(i+branching, len(nodes)):min(i+branching, len(nodes))]})
+		}
+		nodes = parents
+		v.shift += bitsPerLevel
+	}
+	v.root = &node[T]{children: nodes}
+	return v
+}
+
+// Size возвращает количество элементов в векторе.
+func (v *PersistentVector[T]) Size() uint {
+	return v.size
+}
+
+// IsEmpty проверяет что вектор пустой.
+func (v *PersistentVector[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+// tailOffset возвращает индекс первого элемента хвоста.
+func (v *PersistentVector[T]) tailOffset() uint {
+	if v.size < branching {
+		return 0
+	}
+	return ((v.size - 1) >> bitsPerLevel) << bitsPerLevel
+}
+
+// leafFor возвращает лист, содержащий элемент с индексом index.
+func (v *PersistentVector[T]) leafFor(index uint) []T {
+	if index >= v.tailOffset() {
+		return v.tail
+	}
+	n := v.root
+	for level := v.shift; level > 0; level -= bitsPerLevel {
+		n = n.children[(index>>level)&levelMask]
+	}
+	return n.values
+}
+
+// checkIndex вызывает панику, если индекс выходит за границы вектора.
+func (v *PersistentVector[T]) checkIndex(index uint) {
+	if index >= v.size {
+		panic("index out of range")
+	}
+}
+
+// At возвращает элемент вектора по переданному индексу.
+func (v *PersistentVector[T]) At(index uint) T {
+	v.checkIndex(index)
+	return v.leafFor(index)[index&levelMask]
+}
+
+// Front возвращает первый элемент вектора.
+func (v *PersistentVector[T]) Front() T {
+	return v.At(0)
+}
+
+// Back возвращает последний элемент вектора.
+func (v *PersistentVector[T]) Back() T {
+	return v.At(v.size - 1)
+}
+
+// Set возвращает новую версию вектора, в которой элемент с индексом index заменен на value.
+// Если индекс выходит за границы вектора, возникает паника.
+func (v *PersistentVector[T]) Set(index uint, value T) *PersistentVector[T] {
+	v.checkIndex(index)
+	result := *v
+	if index >= v.tailOffset() {
+		result.tail = cloneValues(v.tail, len(v.tail))
+		result.tail[index&levelMask] = value
+		return &result
+	}
+	result.root = v.set(v.shift, v.root, index, value)
+	return &result
+}
+
+// set копирует путь от узла n до листа с элементом index и заменяет элемент.
+func (v *PersistentVector[T]) set(level uint, n *node[T], index uint, value T) *node[T] {
+	if level == 0 {
+		values := cloneValues(n.values, len(n.values))
+		values[index&levelMask] = value
+		return &node[T]{values: values}
+	}
+	c := n.clone()
+	sub := (index >> level) & levelMask
+	c.children[sub] = v.set(level-bitsPerLevel, n.children[sub], index, value)
+	return c
+}
+
+// Append возвращает новую версию вектора с элементом value в конце.
+func (v *PersistentVector[T]) Append(value T) *PersistentVector[T] {
+	result := *v
+	result.size++
+	if v.size-v.tailOffset() < branching {
+		result.tail = cloneValues(v.tail, len(v.tail)+1)
+		result.tail[len(v.tail)] = value
+		return &result
+	}
+
+	// Хвост заполнен: переносим его в дерево и начинаем новый хвост.
+	tailNode := &node[T]{values: v.tail}
+	if (v.size >> bitsPerLevel) > (1 << v.shift) {
+		// В дереве нет места: дерево растет вверх.
+		result.root = &node[T]{children: []*node[T]{v.root, newPath(v.shift, tailNode)}}
+		result.shift += bitsPerLevel
+	} else {
+		result.root = v.pushTail(v.shift, v.root, tailNode)
+	}
+	result.tail = make([]T, 1, branching)
+	result.tail[0] = value
+	return &result
+}
+
+// pushTail копирует правый путь дерева и подвешивает к нему лист tailNode.
+func (v *PersistentVector[T]) pushTail(level uint, parent *node[T], tailNode *node[T]) *node[T] {
+	c := parent.clone()
+	sub := int(((v.size - 1) >> level) & levelMask)
+	var inserted *node[T]
+	switch {
+	case level == bitsPerLevel:
+		inserted = tailNode
+	case sub < len(parent.children):
+		inserted = v.pushTail(level-bitsPerLevel, parent.children[sub], tailNode)
+	default:
+		inserted = newPath(level-bitsPerLevel, tailNode)
+	}
+	if sub < len(c.children) {
+		c.children[sub] = inserted
+	} else {
+		c.children = append(c.children, inserted)
+	}
+	return c
+}
+
+// newPath создает цепочку узлов заданной высоты, ведущую к листу n.
+func newPath[T any](level uint, n *node[T]) *node[T] {
+	if level == 0 {
+		return n
+	}
+	return &node[T]{children: []*node[T]{newPath(level-bitsPerLevel, n)}}
+}
+
+// Pop возвращает новую версию вектора без последнего элемента.
+// Если вектор пустой, возвращает исходную версию.
+func (v *PersistentVector[T]) Pop() *PersistentVector[T] {
+	switch {
+	case v.size == 0:
+		return v
+	case v.size == 1:
+		return NewPersistentVector[T]()
+	}
+
+	result := *v
+	result.size--
+	if v.size-v.tailOffset() > 1 {
+		result.tail = cloneValues(v.tail, len(v.tail)-1)
+		return &result
+	}
+
+	// Хвост из одного элемента: новым хвостом становится последний лист дерева.
+	result.tail = v.leafFor(v.size - 2)
+	root := v.popTail(v.shift, v.root)
+	if root == nil {
+		root = &node[T]{}
+	}
+	if v.shift > bitsPerLevel && len(root.children) == 1 {
+		// У корня остался один потомок: дерево уменьшается в высоту.
+		root = root.children[0]
+		result.shift -= bitsPerLevel
+	}
+	result.root = root
+	return &result
+}
+
+// popTail копирует правый путь дерева без последнего листа.
+// Возвращает nil, если поддерево опустело.
+func (v *PersistentVector[T]) popTail(level uint, n *node[T]) *node[T] {
+	sub := int(((v.size - 2) >> level) & levelMask)
+	if level > bitsPerLevel {
+		child := v.popTail(level-bitsPerLevel, n.children[sub])
+		if child == nil && sub == 0 {
+			return nil
+		}
+		c := n.clone()
+		if child == nil {
+			c.children = c.children[:sub]
+		} else {
+			c.children[sub] = child
+		}
+		return c
+	}
+	if sub == 0 {
+		return nil
+	}
+	c := n.clone()
+	c.children = c.children[:sub]
+	return c
+}
+
+// clone возвращает копию внутреннего узла.
+func (n *node[T]) clone() *node[T] {
+	children := make([]*node[T], len(n.children), branching)
+	copy(children, n.children)
+	return &node[T]{children: children}
+}
+
+// cloneValues возвращает копию первых size элементов листа.
+func cloneValues[T any](values []T, size int) []T {
+	c := make([]T, size, branching)
+	copy(c, values)
+	return c
+}
+
+// Begin возвращает итератор на первый элемент вектора.
+func (v *PersistentVector[T]) Begin() interfaces.RandomAccessIterator[T] {
+	return newIterator(v, 0)
+}
+
+// End возвращает итератор на элемент после последнего.
+func (v *PersistentVector[T]) End() interfaces.RandomAccessIterator[T] {
+	return newIterator(v, v.size)
+}
+
+// RBegin возвращает перевернутый итератор на последний элемент вектора.
+func (v *PersistentVector[T]) RBegin() interfaces.BidirectionalIterator[T] {
+	return iterators.NewReverseIterator[T](newIterator(v, v.size-1))
+}
+
+// REnd возвращает итератор на конец перевернутого вектора.
+func (v *PersistentVector[T]) REnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
+
+// Copy возвращает копию вектора за O(1). Копия разделяет все узлы с исходной версией.
+func (v *PersistentVector[T]) Copy() copiable.Copiable {
+	c := *v
+	return &c
+}
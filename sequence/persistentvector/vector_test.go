@@ -0,0 +1,150 @@
+package persistentvector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// boundarySizes - размеры, на которых хвост переходит в дерево (32, 33)
+// и корень переполняется и растет вверх (1056, 1057).
+var boundarySizes = []int{0, 1, 31, 32, 33, 64, 65, 1024, 1055, 1056, 1057, 1088, 1089}
+
+// seq возвращает слайс 0, 1, ..., n-1.
+func seq(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	return items
+}
+
+// checkEqual сравнивает содержимое вектора со слайсом через At и через итераторы.
+func checkEqual(t *testing.T, expected []int, v *PersistentVector[int]) {
+	t.Helper()
+	require.Equal(t, uint(len(expected)), v.Size())
+	for i := range expected {
+		require.Equal(t, expected[i], v.At(uint(i)), "index %d", i)
+	}
+	i := 0
+	for it := v.Begin(); !it.Equals(v.End()); it.Next() {
+		require.Equal(t, expected[i], it.Value())
+		i++
+	}
+	require.Equal(t, len(expected), i)
+}
+
+func TestAppendAndPopAcrossBoundaries(t *testing.T) {
+	const maxSize = 1100
+	versions := []*PersistentVector[int]{NewPersistentVector[int]()}
+	for i := 0; i < maxSize; i++ {
+		versions = append(versions, versions[i].Append(i))
+	}
+	for _, n := range boundarySizes {
+		checkEqual(t, seq(n), versions[n])
+	}
+	// Дерево на 1057 элементах растет вверх, на 1056 элементах - еще нет.
+	assert.Equal(t, uint(bitsPerLevel), versions[1056].shift)
+	assert.Equal(t, uint(2*bitsPerLevel), versions[1057].shift)
+
+	v := versions[maxSize]
+	for n := maxSize - 1; n >= 0; n-- {
+		v = v.Pop()
+		require.Equal(t, uint(n), v.Size())
+		require.Equal(t, versions[n].shift, v.shift, "size %d", n)
+		if n > 0 {
+			require.Equal(t, n-1, v.Back())
+		}
+	}
+	for _, n := range boundarySizes {
+		v = versions[n]
+		if n > 0 {
+			checkEqual(t, seq(n-1), v.Pop())
+		}
+		checkEqual(t, seq(n+1), v.Append(n))
+		checkEqual(t, seq(n), v.Append(n).Pop())
+	}
+	assert.True(t, NewPersistentVector[int]().Pop().IsEmpty())
+}
+
+func TestNewPersistentVectorMatchesAppend(t *testing.T) {
+	for _, n := range boundarySizes {
+		bulk := NewPersistentVector(seq(n)...)
+		checkEqual(t, seq(n), bulk)
+
+		appended := NewPersistentVector[int]()
+		for i := 0; i < n; i++ {
+			appended = appended.Append(i)
+		}
+		assert.Equal(t, appended.shift, bulk.shift, "size %d", n)
+
+		// Версии, построенные целиком, должны так же изменяться на границах.
+		checkEqual(t, seq(n+1), bulk.Append(n))
+		if n > 0 {
+			checkEqual(t, seq(n-1), bulk.Pop())
+		}
+	}
+}
+
+func TestSetAcrossBoundaries(t *testing.T) {
+	for _, n := range boundarySizes {
+		if n == 0 {
+			continue
+		}
+		v := NewPersistentVector(seq(n)...)
+		for _, index := range []int{0, 31, 32, n - 33, n - 32, n - 1} {
+			if index < 0 || index >= n {
+				continue
+			}
+			updated := v.Set(uint(index), -1)
+			expected := seq(n)
+			expected[index] = -1
+			checkEqual(t, expected, updated)
+			checkEqual(t, seq(n), v)
+		}
+		assert.Panics(t, func() { v.Set(uint(n), 0) })
+	}
+}
+
+func TestOldVersionsUnchanged(t *testing.T) {
+	const size = 1100
+	var versions []*PersistentVector[int]
+	var models [][]int
+
+	v := NewPersistentVector[int]()
+	var model []int
+	for i := 0; i < size; i++ {
+		v = v.Append(i)
+		model = append(model, i)
+		if i%7 == 0 {
+			v = v.Set(uint(i/2), -i)
+			model[i/2] = -i
+		}
+		versions = append(versions, v)
+		models = append(models, append([]int(nil), model...))
+	}
+	// Производные версии от старых не должны затрагивать ни старые, ни более новые версии.
+	for _, n := range boundarySizes {
+		if n == 0 {
+			continue
+		}
+		base := versions[n-1]
+		base.Append(-100).Set(0, -200)
+		base.Pop().Append(-300)
+		base.Set(uint(n-1), -400).Pop()
+	}
+	for i, version := range versions {
+		checkEqual(t, models[i], version)
+		checkEqual(t, models[i][:i], version.Pop())
+	}
+}
+
+func TestCopySharesVersion(t *testing.T) {
+	v := NewPersistentVector(seq(40)...)
+	c := v.Copy().(*PersistentVector[int])
+	updated := c.Set(35, -1).Append(40)
+	checkEqual(t, seq(40), v)
+	checkEqual(t, seq(40), c)
+	assert.Equal(t, -1, updated.At(35))
+}
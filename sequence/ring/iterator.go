@@ -0,0 +1,110 @@
+package ring
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// iterator представляет собой итератор с произвольным доступом для кольцевого буфера.
+// Хранит позицию элемента относительно начала буфера, поэтому переход через границу хранилища
+// для пользователя незаметен.
+type iterator[T any] struct {
+	r       *Ring[T]
+	current uint
+}
+
+// newIterator создает новый итератор.
+func newIterator[T any](r *Ring[T], index uint) *iterator[T] {
+	return &iterator[T]{
+		r:       r,
+		current: index,
+	}
+}
+
+// HasNext проверяет, есть ли следующий элемент.
+func (it *iterator[T]) HasNext() bool {
+	return it.indexInBounds(it.current + 1)
+}
+
+// Next переходит к следующему элементу.
+func (it *iterator[T]) Next() {
+	it.current++
+}
+
+// HasPrev проверяет, есть ли предыдущий элемент.
+func (it *iterator[T]) HasPrev() bool {
+	return it.indexInBounds(it.current - 1)
+}
+
+// Prev переходит к предыдущему элементу.
+func (it *iterator[T]) Prev() {
+	it.current--
+}
+
+// Value возвращает текущее значение итератора.
+func (it *iterator[T]) Value() T {
+	return it.r.At(it.current)
+}
+
+// Ptr возвращает указатель на текущее значение итератора.
+func (it *iterator[T]) Ptr() *T {
+	return it.r.AtPtr(it.current)
+}
+
+// At возвращает указатель на элемент по заданному индексу.
+func (it *iterator[T]) At(index uint) (*T, bool) {
+	if !it.indexInBounds(index) {
+		return nil, false
+	}
+	return it.r.AtPtr(index), true
+}
+
+// Shift смещает итератор на заданное количество элементов.
+// Если смещение положительное - смещает вперед, если отрицательное - назад.
+func (it *iterator[T]) Shift(offset int) {
+	if offset < 0 {
+		it.current -= uint(0 - offset)
+	} else {
+		it.current += uint(offset)
+	}
+}
+
+// Equals проверяет, равен ли данный итератор другому итератору.
+// Итераторы равны, если оба вышли за границы буфера
+// или указывают на одну и ту же позицию одного и того же буфера.
+func (it *iterator[T]) Equals(another interfaces.Iterator) bool {
+	switch a := another.(type) {
+	case *iterator[T]:
+		if a.isEnd() {
+			return it.isEnd()
+		}
+		if it.isEnd() {
+			return false
+		}
+		return a.r == it.r && a.current == it.current
+	case *iterators.EndIterator:
+		return it.isEnd()
+	}
+	panic("unknown iterator type")
+}
+
+func (it *iterator[T]) indexInBounds(index uint) bool {
+	return it.r.size > index
+}
+
+// isEnd проверяет, что итератор вышел за границы буфера: перешел за последний элемент
+// или, при обратном обходе, перед первым.
+func (it *iterator[T]) isEnd() bool {
+	return it.current >= it.r.size
+}
+
+// Copy копирует итератор.
+func (it *iterator[T]) Copy() copiable.Copiable {
+	return newIterator(it.r, it.current)
+}
+
+// Index возвращает текущий индекс итератора.
+func (it *iterator[T]) Index() uint {
+	return it.current
+}
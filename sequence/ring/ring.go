@@ -0,0 +1,222 @@
+package ring
+
+import (
+	"github.com/Delisa-sama/collections/copiable"
+	"github.com/Delisa-sama/collections/interfaces"
+	"github.com/Delisa-sama/collections/iterators"
+)
+
+// OverflowPolicy определяет поведение кольцевого буфера при добавлении элемента в заполненный буфер.
+type OverflowPolicy uint8
+
+const (
+	// Overwrite вытесняет элемент с противоположного конца буфера:
+	// PushBack удаляет первый элемент, PushFront - последний.
+	Overwrite OverflowPolicy = iota
+	// Reject отклоняет добавление, буфер не изменяется.
+	Reject
+	// Grow увеличивает емкость буфера вдвое.
+	Grow
+)
+
+const growFactor = 2 // Во сколько раз увеличивается емкость буфера при политике Grow.
+
+// Ring представляет собой кольцевой буфер фиксированной емкости.
+// Элементы хранятся в одном слайсе, начало буфера перемещается по кругу,
+// поэтому добавление и удаление с обоих концов выполняются за O(1) без копирования элементов.
+type Ring[T any] struct {
+	buf    []T            // Хранилище элементов, его длина равна емкости буфера
+	head   uint           // Индекс первого элемента в buf
+	size   uint           // Количество элементов в буфере
+	policy OverflowPolicy // Поведение при переполнении
+}
+
+// NewRing создает новый кольцевой буфер заданной емкости с политикой переполнения policy
+// и заполняет его переданными элементами. Элементы добавляются в конец буфера по одному,
+// поэтому при избытке элементов применяется политика переполнения.
+func NewRing[T any](capacity uint, policy OverflowPolicy, items ...T) *Ring[T] {
+	if capacity == 0 {
+		panic("capacity must be positive")
+	}
+	r := &Ring[T]{
+		buf:    make([]T, capacity),
+		policy: policy,
+	}
+	for i := range items {
+		r.PushBack(items[i])
+	}
+	return r
+}
+
+// physical возвращает индекс в buf для элемента с позицией index в буфере.
+func (r *Ring[T]) physical(index uint) uint {
+	i := r.head + index
+	if i >= uint(len(r.buf)) {
+		i -= uint(len(r.buf))
+	}
+	return i
+}
+
+// Size возвращает количество элементов в буфере.
+func (r *Ring[T]) Size() uint {
+	return r.size
+}
+
+// Cap возвращает емкость буфера.
+func (r *Ring[T]) Cap() uint {
+	return uint(len(r.buf))
+}
+
+// Policy возвращает политику переполнения буфера.
+func (r *Ring[T]) Policy() OverflowPolicy {
+	return r.policy
+}
+
+// IsEmpty проверяет, что буфер пустой.
+func (r *Ring[T]) IsEmpty() bool {
+	return r.size == 0
+}
+
+// IsFull проверяет, что буфер заполнен.
+func (r *Ring[T]) IsFull() bool {
+	return r.size == uint(len(r.buf))
+}
+
+// makeRoom освобождает место под новый элемент согласно политике переполнения.
+// evict удаляет элемент с противоположного конца при политике Overwrite.
+// Возвращает false, если добавление должно быть отклонено.
+func (r *Ring[T]) makeRoom(evict func()) bool {
+	if !r.IsFull() {
+		return true
+	}
+	switch r.policy {
+	case Overwrite:
+		evict()
+	case Reject:
+		return false
+	case Grow:
+		r.grow()
+	}
+	return true
+}
+
+// grow увеличивает емкость буфера, переупорядочивая элементы с начала хранилища.
+func (r *Ring[T]) grow() {
+	buf := make([]T, uint(len(r.buf))*growFactor)
+	n := copy(buf, r.buf[r.head:])
+	copy(buf[n:], r.buf[:r.head])
+	r.buf = buf
+	r.head = 0
+}
+
+// PushBack добавляет элемент в конец буфера.
+// Если буфер заполнен, при политике Overwrite удаляет первый элемент,
+// при политике Grow увеличивает емкость, а при политике Reject не добавляет элемент и возвращает false.
+func (r *Ring[T]) PushBack(value T) bool {
+	if !r.makeRoom(r.PopFront) {
+		return false
+	}
+	r.buf[r.physical(r.size)] = value
+	r.size++
+	return true
+}
+
+// PushFront добавляет элемент в начало буфера.
+// Если буфер заполнен, при политике Overwrite удаляет последний элемент,
+// при политике Grow увеличивает емкость, а при политике Reject не добавляет элемент и возвращает false.
+func (r *Ring[T]) PushFront(value T) bool {
+	if !r.makeRoom(r.PopBack) {
+		return false
+	}
+	r.head = r.physical(uint(len(r.buf)) - 1)
+	r.buf[r.head] = value
+	r.size++
+	return true
+}
+
+// Front возвращает первый элемент буфера без его удаления.
+func (r *Ring[T]) Front() T {
+	return r.At(0)
+}
+
+// Back возвращает последний элемент буфера без его удаления.
+func (r *Ring[T]) Back() T {
+	return r.At(r.size - 1)
+}
+
+// PopFront удаляет первый элемент из буфера.
+func (r *Ring[T]) PopFront() {
+	if r.size == 0 {
+		return
+	}
+	var zero T
+	r.buf[r.head] = zero
+	r.head = r.physical(1)
+	r.size--
+}
+
+// PopBack удаляет последний элемент из буфера.
+func (r *Ring[T]) PopBack() {
+	if r.size == 0 {
+		return
+	}
+	var zero T
+	r.buf[r.physical(r.size-1)] = zero
+	r.size--
+}
+
+// At возвращает элемент на указанной позиции в буфере, считая от первого элемента.
+func (r *Ring[T]) At(index uint) T {
+	return *r.AtPtr(index)
+}
+
+// AtPtr возвращает указатель на элемент на указанной позиции в буфере, считая от первого элемента.
+func (r *Ring[T]) AtPtr(index uint) *T {
+	if index >= r.size {
+		panic("index out of range")
+	}
+	return &r.buf[r.physical(index)]
+}
+
+// Clear удаляет все элементы из буфера. Емкость буфера сохраняется.
+func (r *Ring[T]) Clear() {
+	clear(r.buf)
+	r.head = 0
+	r.size = 0
+}
+
+// Copy создает и возвращает копию буфера.
+func (r *Ring[T]) Copy() copiable.Copiable {
+	buf := make([]T, len(r.buf))
+	copy(buf, r.buf)
+	return &Ring[T]{
+		buf:    buf,
+		head:   r.head,
+		size:   r.size,
+		policy: r.policy,
+	}
+}
+
+// Begin возвращает итератор на первый элемент буфера.
+func (r *Ring[T]) Begin() interfaces.RandomAccessIterator[T] {
+	return newIterator(r, 0)
+}
+
+// End возвращает итератор на элемент после последнего.
+func (r *Ring[T]) End() interfaces.RandomAccessIterator[T] {
+	return newIterator(r, r.size)
+}
+
+// RBegin возвращает перевернутый итератор на последний элемент буфера.
+// Для пустого буфера возвращает итератор на конец перевернутого буфера.
+func (r *Ring[T]) RBegin() interfaces.BidirectionalIterator[T] {
+	if r.IsEmpty() {
+		return iterators.NewReverseIterator[T](r.End())
+	}
+	return iterators.NewReverseIterator[T](newIterator(r, r.size-1))
+}
+
+// REnd возвращает итератор на конец перевернутого буфера.
+func (r *Ring[T]) REnd() interfaces.Iterator {
+	return iterators.NewEndIterator()
+}
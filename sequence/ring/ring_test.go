@@ -0,0 +1,116 @@
+package ring
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Delisa-sama/collections/algorithms"
+)
+
+func items[T any](r *Ring[T]) []T {
+	result := make([]T, 0, r.Size())
+	for it := r.Begin(); !it.Equals(r.End()); it.Next() {
+		result = append(result, it.Value())
+	}
+	return result
+}
+
+func TestSortAndBinarySearchAfterOverwrite(t *testing.T) {
+	const capacity = 37
+	rnd := rand.New(rand.NewSource(1))
+
+	r := NewRing[int](capacity, Overwrite)
+	var model []int
+	// Заполняем буфер с избытком, чтобы начало буфера сместилось и элементы перешли через границу хранилища.
+	for i := 0; i < capacity*3+5; i++ {
+		v := rnd.Intn(1000)
+		r.PushBack(v)
+		model = append(model, v)
+		if len(model) > capacity {
+			model = model[1:]
+		}
+	}
+	require.True(t, r.IsFull())
+	require.NotZero(t, r.head)
+	require.Equal(t, model, items(r))
+
+	algorithms.Sort(r.Begin(), r.End())
+	slices.Sort(model)
+	assert.Equal(t, model, items(r))
+
+	for v := -1; v <= 1000; v++ {
+		assert.Equal(t, slices.Contains(model, v), algorithms.BinarySearch(r.Begin(), r.End(), v), v)
+	}
+}
+
+func TestPushFrontPopBackAcrossSeam(t *testing.T) {
+	r := NewRing[int](4, Reject)
+	var model []int
+	for i := 0; i < 50; i++ {
+		if len(model) < 4 {
+			require.True(t, r.PushFront(i))
+			model = append([]int{i}, model...)
+		} else {
+			require.False(t, r.PushFront(i))
+		}
+		if i%3 == 2 {
+			r.PopBack()
+			model = model[:len(model)-1]
+		}
+		require.Equal(t, model, items(r), i)
+		if len(model) > 0 {
+			assert.Equal(t, model[0], r.Front())
+			assert.Equal(t, model[len(model)-1], r.Back())
+		}
+	}
+}
+
+func TestOverwritePushFrontEvictsBack(t *testing.T) {
+	r := NewRing[int](3, Overwrite, 1, 2, 3)
+	r.PushFront(0)
+	assert.Equal(t, []int{0, 1, 2}, items(r))
+	r.PushFront(-1)
+	assert.Equal(t, []int{-1, 0, 1}, items(r))
+}
+
+func TestGrowWithShiftedHead(t *testing.T) {
+	r := NewRing[int](4, Grow, 1, 2, 3, 4)
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(5)
+	r.PushBack(6)
+	require.Equal(t, uint(2), r.head)
+	require.True(t, r.IsFull())
+
+	require.True(t, r.PushBack(7))
+	assert.Equal(t, uint(8), r.Cap())
+	assert.Equal(t, []int{3, 4, 5, 6, 7}, items(r))
+
+	r = NewRing[int](4, Grow, 1, 2, 3, 4)
+	r.PopBack()
+	r.PushFront(0)
+	require.NotZero(t, r.head)
+	require.True(t, r.PushFront(-1))
+	assert.Equal(t, uint(8), r.Cap())
+	assert.Equal(t, []int{-1, 0, 1, 2, 3}, items(r))
+}
+
+func TestRBegin(t *testing.T) {
+	r := NewRing[int](3, Overwrite)
+	assert.True(t, r.RBegin().Equals(r.REnd()))
+	assert.False(t, r.RBegin().HasNext())
+
+	r.PushBack(1)
+	r.PushBack(2)
+	r.PushBack(3)
+	r.PushBack(4)
+	var reversed []int
+	for it := r.RBegin(); !it.Equals(r.REnd()); it.Next() {
+		reversed = append(reversed, it.Value())
+	}
+	assert.Equal(t, []int{4, 3, 2}, reversed)
+}
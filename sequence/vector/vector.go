@@ -98,6 +98,13 @@ func (l *Vector[T]) PushFront(value T) {
 	l.s = append([]T{value}, l.s...)
 }
 
+// Insert вставляет элемент на позицию index, сдвигая последующие элементы вправо.
+func (l *Vector[T]) Insert(index uint, value T) {
+	l.s = append(l.s, value)
+	copy(l.s[index+1:], l.s[index:])
+	l.s[index] = value
+}
+
 // Copy копирует вектор.
 func (l *Vector[T]) Copy() copiable.Copiable {
 	sliceCopy := make([]T, len(l.s))